@@ -0,0 +1,221 @@
+package validator
+
+import (
+	"net/netip"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// CanonicalOptions configures CanonicalURLWith.
+type CanonicalOptions struct {
+	// SortQuery orders query parameters by name. Parameters sharing a name
+	// keep their relative order.
+	SortQuery bool
+}
+
+// CanonicalURL returns the canonical form of an URL accepted by IsURL.
+// See CanonicalURLWith for the applied normalizations.
+func CanonicalURL(str string) (string, error) {
+	return CanonicalURLWith(str, CanonicalOptions{})
+}
+
+// CanonicalURLWith returns the canonical form of an URL accepted by IsURL:
+// the scheme and host are lowercased, internationalized host labels are
+// converted to Punycode, IPv6 addresses are written in their RFC 5952 form,
+// the default port of the scheme is removed, dot-segments are resolved,
+// percent-encodings are uppercased and unreserved characters decoded. URLs without a scheme are assumed to be http.
+// Other strings yield the *URLError reported by ValidateURL.
+func CanonicalURLWith(str string, opts CanonicalOptions) (string, error) {
+	if err := ValidateURL(str); err != nil {
+		return "", err
+	}
+	if urlScheme(str) == "" {
+		str = "http://" + str
+	}
+
+	u, err := url.Parse(str)
+	if err != nil {
+		return "", err
+	}
+
+	var b strings.Builder
+	scheme := strings.ToLower(u.Scheme)
+	b.WriteString(scheme)
+	b.WriteString("://")
+
+	if u.User != nil {
+		b.WriteString(normalizePercentEncoding(u.User.String()))
+		b.WriteByte('@')
+	}
+
+	host := u.Hostname()
+	if strings.Contains(host, ":") {
+		// The zone of a link-local address is kept percent-encoded (RFC 6874).
+		host = strings.ToLower(host)
+		if addr, err := netip.ParseAddr(u.Hostname()); err == nil {
+			host = addr.WithZone("").String()
+			if zone := addr.Zone(); zone != "" {
+				host += "%25" + url.PathEscape(zone)
+			}
+		}
+		b.WriteString("[" + host + "]")
+	} else {
+		host, err = toASCIIHost(host)
+		if err != nil {
+			return "", err
+		}
+		b.WriteString(host)
+	}
+
+	if p := u.Port(); p != "" {
+		port, err := strconv.Atoi(p)
+		if err != nil {
+			return "", err
+		}
+		if def, ok := defaultURLPorts[scheme]; !ok || def != port {
+			b.WriteString(":" + strconv.Itoa(port))
+		}
+	}
+
+	path := removeDotSegments(normalizePercentEncoding(u.EscapedPath()))
+	if path == "" {
+		path = "/"
+	}
+	b.WriteString(path)
+
+	if u.RawQuery != "" || u.ForceQuery {
+		query := normalizePercentEncoding(u.RawQuery)
+		if opts.SortQuery {
+			query = sortQuery(query)
+		}
+		b.WriteString("?" + query)
+	}
+	if u.Fragment != "" {
+		b.WriteString("#" + normalizePercentEncoding(u.EscapedFragment()))
+	}
+
+	return b.String(), nil
+}
+
+// EquivalentURLs checks if both strings are URLs with the same canonical form.
+func EquivalentURLs(a, b string) bool {
+	ca, err := CanonicalURL(a)
+	if err != nil {
+		return false
+	}
+	cb, err := CanonicalURL(b)
+	if err != nil {
+		return false
+	}
+
+	return ca == cb
+}
+
+// normalizePercentEncoding uppercases the hex digits of percent-encoded
+// octets, decodes octets of unreserved characters and encodes raw non-ASCII
+// bytes, as described in RFC 3986 section 6.2.2.
+func normalizePercentEncoding(str string) string {
+	const hex = "0123456789ABCDEF"
+
+	var b strings.Builder
+	for i := 0; i < len(str); i++ {
+		c := str[i]
+		switch {
+		case c == '%' && i+2 < len(str) && isHex(str[i+1]) && isHex(str[i+2]):
+			v := unhex(str[i+1])<<4 | unhex(str[i+2])
+			if isUnreserved(v) {
+				b.WriteByte(v)
+			} else {
+				b.WriteByte('%')
+				b.WriteByte(hex[v>>4])
+				b.WriteByte(hex[v&0x0f])
+			}
+			i += 2
+		case c >= 0x80:
+			b.WriteByte('%')
+			b.WriteByte(hex[c>>4])
+			b.WriteByte(hex[c&0x0f])
+		default:
+			b.WriteByte(c)
+		}
+	}
+
+	return b.String()
+}
+
+// removeDotSegments implements RFC 3986 section 5.2.4.
+func removeDotSegments(path string) string {
+	var out []string
+	in := path
+	for in != "" {
+		switch {
+		case strings.HasPrefix(in, "../"):
+			in = in[3:]
+		case strings.HasPrefix(in, "./"):
+			in = in[2:]
+		case strings.HasPrefix(in, "/./"):
+			in = in[2:]
+		case in == "/.":
+			in = "/"
+		case strings.HasPrefix(in, "/../"):
+			in = in[3:]
+			if len(out) > 0 {
+				out = out[:len(out)-1]
+			}
+		case in == "/..":
+			in = "/"
+			if len(out) > 0 {
+				out = out[:len(out)-1]
+			}
+		case in == "." || in == "..":
+			in = ""
+		default:
+			i := strings.IndexByte(in[1:], '/')
+			if i < 0 {
+				out = append(out, in)
+				in = ""
+			} else {
+				out = append(out, in[:i+1])
+				in = in[i+1:]
+			}
+		}
+	}
+
+	return strings.Join(out, "")
+}
+
+func sortQuery(query string) string {
+	params := strings.Split(query, "&")
+	sort.SliceStable(params, func(i, j int) bool {
+		return queryKey(params[i]) < queryKey(params[j])
+	})
+
+	return strings.Join(params, "&")
+}
+
+func queryKey(param string) string {
+	key, _, _ := strings.Cut(param, "=")
+	return key
+}
+
+func isHex(c byte) bool {
+	return '0' <= c && c <= '9' || 'a' <= c && c <= 'f' || 'A' <= c && c <= 'F'
+}
+
+func unhex(c byte) byte {
+	switch {
+	case '0' <= c && c <= '9':
+		return c - '0'
+	case 'a' <= c && c <= 'f':
+		return c - 'a' + 10
+	default:
+		return c - 'A' + 10
+	}
+}
+
+func isUnreserved(c byte) bool {
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9' ||
+		c == '-' || c == '.' || c == '_' || c == '~'
+}
@@ -0,0 +1,136 @@
+package validator
+
+import (
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// StringChain validates a single string with chained rules, e.g.
+//
+//	err := validator.String(email).Named("email").Required().MaxRunes(254).Email().Err()
+//
+// As in struct validation, rules other than Required accept the empty string.
+type StringChain struct {
+	name     string
+	value    string
+	failures []FieldError
+}
+
+// String starts a chain validating value, reported as "value" unless Named is
+// called.
+func String(value string) *StringChain {
+	return &StringChain{name: "value", value: value}
+}
+
+// Named sets the field name reported in the errors of the chain.
+func (c *StringChain) Named(name string) *StringChain {
+	c.name = name
+	return c
+}
+
+// Check checks the string against rule, reporting failures as rule name.
+func (c *StringChain) Check(name string, rule Rule) *StringChain {
+	return c.check(name, "", rule)
+}
+
+func (c *StringChain) check(rule, param string, valid func(str string) bool) *StringChain {
+	if IsNotNull(c.value) && !valid(c.value) {
+		c.failures = append(c.failures, FieldError{Rule: rule, Param: param})
+	}
+
+	return c
+}
+
+// Required checks that the string is not empty.
+func (c *StringChain) Required() *StringChain {
+	if IsNull(c.value) {
+		c.failures = append(c.failures, FieldError{Rule: "required"})
+	}
+
+	return c
+}
+
+// MinRunes checks that the string has at least min runes.
+func (c *StringChain) MinRunes(min int) *StringChain {
+	return c.check("minrunes", strconv.Itoa(min), func(str string) bool {
+		return utf8.RuneCountInString(str) >= min
+	})
+}
+
+// MaxRunes checks that the string has at most max runes.
+func (c *StringChain) MaxRunes(max int) *StringChain {
+	return c.check("maxrunes", strconv.Itoa(max), func(str string) bool {
+		return utf8.RuneCountInString(str) <= max
+	})
+}
+
+// Email checks the string with IsEmail.
+func (c *StringChain) Email() *StringChain {
+	return c.check("email", "", IsEmail)
+}
+
+// URL checks the string with IsURL.
+func (c *StringChain) URL() *StringChain {
+	return c.check("url", "", IsURL)
+}
+
+// Alpha checks the string with IsAlpha.
+func (c *StringChain) Alpha() *StringChain {
+	return c.check("alpha", "", IsAlpha)
+}
+
+// Alphanumeric checks the string with IsAlphanumeric.
+func (c *StringChain) Alphanumeric() *StringChain {
+	return c.check("alphanum", "", IsAlphanumeric)
+}
+
+// Numeric checks the string with IsNumeric.
+func (c *StringChain) Numeric() *StringChain {
+	return c.check("numeric", "", IsNumeric)
+}
+
+// ASCII checks the string with IsASCII.
+func (c *StringChain) ASCII() *StringChain {
+	return c.check("ascii", "", IsASCII)
+}
+
+// PrintableASCII checks the string with IsPrintableASCII.
+func (c *StringChain) PrintableASCII() *StringChain {
+	return c.check("printableascii", "", IsPrintableASCII)
+}
+
+// UTFLetter checks the string with IsUTFLetter.
+func (c *StringChain) UTFLetter() *StringChain {
+	return c.check("utfletter", "", IsUTFLetter)
+}
+
+// Matches checks the string with Matches.
+func (c *StringChain) Matches(pattern string) *StringChain {
+	return c.check("matches", pattern, func(str string) bool {
+		return Matches(str, pattern)
+	})
+}
+
+// In checks the string with IsIn.
+func (c *StringChain) In(values ...string) *StringChain {
+	return c.check("in", strings.Join(values, "|"), func(str string) bool {
+		return IsIn(str, values...)
+	})
+}
+
+// Valid reports whether the string passed every rule of the chain.
+func (c *StringChain) Valid() bool {
+	return len(c.failures) == 0
+}
+
+// Err returns the failures of the chain as Errors, as ValidateStruct does,
+// or nil if the string passed every rule.
+func (c *StringChain) Err() error {
+	var errs Errors
+	for _, failure := range c.failures {
+		errs = append(errs, newFieldError(fieldPath{path: c.name, pointer: "/" + escapePointer(c.name), jsonPath: c.name}, failure.Rule, failure.Param, c.value))
+	}
+
+	return errs.errOrNil()
+}
@@ -0,0 +1,71 @@
+package validator
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestStringChain(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		chain    *StringChain
+		expected []string
+	}{
+		{String("foo@bar.com").Named("email").Required().MaxRunes(254).Email(), nil},
+		{String("").Named("email").Email().MinRunes(3), nil},
+		{String("").Named("email").Required().Email(), []string{
+			"validator: email is required",
+		}},
+		{String("foo").Named("email").Required().MinRunes(5).MaxRunes(2).Email(), []string{
+			"validator: email does not validate as minrunes(5)",
+			"validator: email does not validate as maxrunes(2)",
+			"validator: email does not validate as email",
+		}},
+		{String("你好").MinRunes(2).MaxRunes(2).UTFLetter(), nil},
+		{String("abc1").Alpha().Alphanumeric().Numeric(), []string{
+			"validator: value does not validate as alpha",
+			"validator: value does not validate as numeric",
+		}},
+		{String("ｆｏｏ").ASCII().PrintableASCII().URL(), []string{
+			"validator: value does not validate as ascii",
+			"validator: value does not validate as printableascii",
+			"validator: value does not validate as url",
+		}},
+		{String("mid").Named("level").In("low", "high").Matches("^[a-z]+$"), []string{
+			"validator: level does not validate as in(low|high)",
+		}},
+		{String("a,b").Named("tags").Check("tags", Each(",", Strict(IsNumeric))), []string{
+			"validator: tags does not validate as tags",
+		}},
+	}
+	for _, test := range tests {
+		err := test.chain.Err()
+		if actual := errorMessages(err); !reflect.DeepEqual(actual, test.expected) {
+			t.Errorf("Expected the chain on %q to fail with %q, got %q", test.chain.value, test.expected, actual)
+		}
+		if test.chain.Valid() != (err == nil) {
+			t.Errorf("Expected Valid() of the chain on %q to be %v", test.chain.value, err == nil)
+		}
+	}
+}
+
+func TestStringChainErrorType(t *testing.T) {
+	t.Parallel()
+
+	var chainErr, structErr *FieldError
+	err := String("").Named("Email").Required().Err()
+	if !errors.As(err, &chainErr) {
+		t.Fatalf("Expected a field error, got %v", err)
+	}
+	err = ValidateStruct(struct {
+		Email string `valid:"required"`
+	}{})
+	if !errors.As(err, &structErr) {
+		t.Fatalf("Expected a field error, got %v", err)
+	}
+	if *chainErr != *structErr {
+		t.Errorf("Expected the chain and struct errors to match, got %+v and %+v", chainErr, structErr)
+	}
+}
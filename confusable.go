@@ -0,0 +1,186 @@
+package validator
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// confusables maps characters to the prototype they are visually confusable
+// with. It is a subset of the Unicode TR39 confusables.txt data covering the
+// Latin, Greek, Cyrillic and Armenian lookalikes most used for spoofing;
+// fullwidth ASCII forms are folded separately in Skeleton.
+var confusables = map[rune]string{
+	// Latin
+	'0': "O", '1': "l", 'I': "l", '|': "l", 'm': "rn",
+	'ǀ': "l", 'ı': "i", 'ɑ': "a", 'ɡ': "g", 'ɩ': "i", 'ᴄ': "c", 'ᴏ': "o", 'ᴢ': "z", 'ꜱ': "s",
+	'\u212A': "K", 'ℓ': "l", '℮': "e",
+
+	// Greek
+	'Α': "A", 'Β': "B", 'Ε': "E", 'Ζ': "Z", 'Η': "H", 'Ι': "l", 'Κ': "K", 'Μ': "M",
+	'Ν': "N", 'Ο': "O", 'Ρ': "P", 'Τ': "T", 'Υ': "Y", 'Χ': "X",
+	'α': "a", 'γ': "y", 'ι': "i", 'ν': "v", 'ο': "o", 'ρ': "p", 'υ': "u",
+	'ϲ': "c", 'ϳ': "j", 'Ϲ': "C",
+
+	// Cyrillic
+	'А': "A", 'В': "B", 'С': "C", 'Е': "E", 'Н': "H", 'І': "l", 'Ј': "J", 'К': "K",
+	'М': "M", 'О': "O", 'Р': "P", 'Ѕ': "S", 'Т': "T", 'Х': "X", 'Ү': "Y", 'Ӏ': "l",
+	'а': "a", 'Ь': "b", 'с': "c", 'ԁ': "d", 'е': "e", 'ҽ': "e", 'һ': "h", 'і': "i",
+	'ј': "j", 'о': "o", 'р': "p", 'ԛ': "q", 'ѕ': "s", 'у': "y", 'ѵ': "v", 'ԝ': "w",
+	'ѡ': "w", 'х': "x", 'ӏ': "l",
+
+	// Armenian
+	'Օ': "O", 'ց': "g", 'հ': "h", 'ո': "n", 'օ': "o", 'ս': "u",
+
+	// Punctuation
+	'‐': "-", '‑': "-", '‒': "-", '–': "-", '—': "-", '−': "-",
+}
+
+// Skeleton returns an approximation of the TR39 skeleton of str: every
+// character is replaced by the prototype it is visually confusable with, so
+// two strings that look alike have the same skeleton. Unlike TR39, the mapping
+// only covers the Latin, Greek, Cyrillic and Armenian lookalikes listed in
+// confusables and no NFD normalization is applied, so the composed é and the
+// decomposed e followed by U+0301 have different skeletons; normalize strings
+// to NFD beforehand, e.g. with golang.org/x/text/unicode/norm, to compare them.
+// The result is only meant for comparisons, never for display.
+func Skeleton(str string) string {
+	var b strings.Builder
+	for _, c := range str {
+		if 0xFF01 <= c && c <= 0xFF5E { // fullwidth ASCII variants
+			c -= 0xFF01 - '!'
+		}
+		if proto, ok := confusables[c]; ok {
+			b.WriteString(proto)
+			continue
+		}
+		b.WriteRune(c)
+	}
+
+	return b.String()
+}
+
+// AreConfusable checks if the two strings look alike, i.e. have the same Skeleton.
+func AreConfusable(a, b string) bool {
+	return Skeleton(a) == Skeleton(b)
+}
+
+// IsSingleScriptEmail checks if the string is an email, see IsEmail, whose
+// domain labels are each written in a single script, see IsSingleScript, as
+// URLOptions.ForbidMixedScriptHost requires for URLs.
+func IsSingleScriptEmail(str string) bool {
+	at := strings.LastIndex(str, "@")
+	return IsEmail(str) && isSingleScriptHost(str[at+1:])
+}
+
+// IsSingleScript checks if all the letters of the string belong to one script,
+// ignoring characters of the Common and Inherited scripts. Following TR39,
+// Han combined with Hiragana and Katakana, Hangul or Bopomofo counts as a
+// single script. Empty string is valid.
+func IsSingleScript(str string) bool {
+	if IsNull(str) {
+		return emptyStringValid()
+	}
+
+	var resolved []string
+	first := true
+	for _, c := range str {
+		set := augmentedScripts(scriptOf(c))
+		if set == nil {
+			continue
+		}
+		if first {
+			resolved, first = set, false
+			continue
+		}
+
+		var common []string
+		for _, s := range resolved {
+			for _, t := range set {
+				if s == t {
+					common = append(common, s)
+				}
+			}
+		}
+		if len(common) == 0 {
+			return false
+		}
+		resolved = common
+	}
+
+	return true
+}
+
+// IsMixedScript checks if the string contains letters of more than one script,
+// such as "pаypal" with a Cyrillic "а". See IsSingleScript.
+func IsMixedScript(str string) bool {
+	return IsNotNull(str) && !IsSingleScript(str)
+}
+
+// scriptRange is a range of code points of the script name.
+type scriptRange struct {
+	lo, hi rune
+	name   string
+}
+
+// scriptIndex holds the ranges of unicode.Scripts sorted by code point, so
+// that scriptOf looks up a script with a binary search.
+var scriptIndex = buildScriptIndex()
+
+func buildScriptIndex() []scriptRange {
+	var index []scriptRange
+	add := func(lo, hi, stride rune, name string) {
+		if stride == 1 {
+			index = append(index, scriptRange{lo, hi, name})
+			return
+		}
+		// Strided ranges may interleave with other scripts.
+		for c := lo; c <= hi; c += stride {
+			index = append(index, scriptRange{c, c, name})
+		}
+	}
+	for name, table := range unicode.Scripts {
+		for _, r := range table.R16 {
+			add(rune(r.Lo), rune(r.Hi), rune(r.Stride), name)
+		}
+		for _, r := range table.R32 {
+			add(rune(r.Lo), rune(r.Hi), rune(r.Stride), name)
+		}
+	}
+	sort.Slice(index, func(i, j int) bool {
+		return index[i].lo < index[j].lo
+	})
+
+	return index
+}
+
+// scriptOf returns the name of the script of c as used in unicode.Scripts.
+func scriptOf(c rune) string {
+	i := sort.Search(len(scriptIndex), func(i int) bool {
+		return scriptIndex[i].hi >= c
+	})
+	if i < len(scriptIndex) && scriptIndex[i].lo <= c {
+		return scriptIndex[i].name
+	}
+
+	return "Unknown"
+}
+
+// augmentedScripts returns the augmented script set of TR39 section 5.1 for
+// script, or nil for Common and Inherited.
+func augmentedScripts(script string) []string {
+	switch script {
+	case "Common", "Inherited":
+		return nil
+	case "Han":
+		return []string{"Hanb", "Jpan", "Kore"}
+	case "Hiragana", "Katakana":
+		return []string{"Jpan"}
+	case "Hangul":
+		return []string{"Kore"}
+	case "Bopomofo":
+		return []string{"Hanb"}
+	default:
+		return []string{script}
+	}
+}
@@ -0,0 +1,155 @@
+package validator
+
+import (
+	"testing"
+	"unicode"
+)
+
+func TestSkeleton(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected string
+	}{
+		{"", ""},
+		{"paypal", "paypal"},
+		{"pаypal", "paypal"},
+		{"ΡΑΥΡΑl", "PAYPAl"},
+		{"g00gle", "gOOgle"},
+		{"GOOGLE", "GOOGLE"},
+		{"ｐａｙｐａｌ", "paypal"},
+		{"admin1", "adrninl"},
+		{"adminI", "adrninl"},
+		{"小林", "小林"},
+	}
+	for _, test := range tests {
+		actual := Skeleton(test.param)
+		if actual != test.expected {
+			t.Errorf("Expected Skeleton(%q) to be %q, got %q", test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestAreConfusable(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		a        string
+		b        string
+		expected bool
+	}{
+		{"paypal", "paypol", false},
+		{"admin", "adm1n", false},
+		{"apple", "APPLE", false},
+
+		{"", "", true},
+		{"paypal", "pаypal", true},
+		{"apple", "аррӏе", true},
+		{"scope", "ѕсоре", true},
+		{"rnicrosoft", "microsoft", true},
+		{"admin1", "adminl", true},
+		{"google", "ｇｏｏｇｌｅ", true},
+	}
+	for _, test := range tests {
+		actual := AreConfusable(test.a, test.b)
+		if actual != test.expected {
+			t.Errorf("Expected AreConfusable(%q, %q) to be %v, got %v", test.a, test.b, test.expected, actual)
+		}
+	}
+}
+
+func TestIsSingleScriptEmail(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"", false},
+		{"foo", false},
+		{"foo@pаypal.com", false},
+		{"foo@xn--pypal-4ve.com", false},
+
+		{"foo@paypal.com", true},
+		{"pаypal@paypal.com", true},
+		{"foo@пример.рф", true},
+		{"foo@bücher.example", true},
+	}
+	for _, test := range tests {
+		actual := IsSingleScriptEmail(test.param)
+		if actual != test.expected {
+			t.Errorf("Expected IsSingleScriptEmail(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestIsSingleScript(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"pаypal", false},
+		{"Ρaypal", false},
+		{"abc소주", false},
+		{"カタカナ한국", false},
+		{"漢字abc", false},
+
+		{"", true},
+		{"123", true},
+		{"paypal", true},
+		{"user_name-42", true},
+		{"пример", true},
+		{"Ελλάδα", true},
+		{"漢字ひらがなカタカナ", true},
+		{"韓國어", true},
+		{"café", true},
+	}
+	for _, test := range tests {
+		actual := IsSingleScript(test.param)
+		if actual != test.expected {
+			t.Errorf("Expected IsSingleScript(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestIsMixedScript(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"", false},
+		{"paypal", false},
+		{"漢字ひらがな", false},
+
+		{"pаypal", true},
+		{"abc소주", true},
+	}
+	for _, test := range tests {
+		actual := IsMixedScript(test.param)
+		if actual != test.expected {
+			t.Errorf("Expected IsMixedScript(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestScriptOf(t *testing.T) {
+	t.Parallel()
+
+	for c := rune(0); c <= unicode.MaxRune; c += 7 {
+		expected := "Unknown"
+		for name, table := range unicode.Scripts {
+			if unicode.Is(table, c) {
+				expected = name
+				break
+			}
+		}
+		if actual := scriptOf(c); actual != expected {
+			t.Fatalf("Expected scriptOf(%U) to be %s, got %s", c, expected, actual)
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package validator
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+)
+
+// ToInt converts value, a signed or unsigned integer or a base 10 string,
+// to int64. It returns an error for other types, malformed strings and
+// values out of the int64 range.
+func ToInt(value interface{}) (int64, error) {
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int(), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		if v.Uint() > math.MaxInt64 {
+			return 0, fmt.Errorf("validator: ToInt: %d overflows int64", v.Uint())
+		}
+		return int64(v.Uint()), nil
+	case reflect.String:
+		i, err := strconv.ParseInt(v.String(), 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("validator: ToInt: %w", err)
+		}
+		return i, nil
+	}
+
+	return 0, fmt.Errorf("validator: ToInt: unsupported type %T", value)
+}
+
+// ToFloat converts value, a number or a string, to float64. It returns an
+// error for other types and malformed strings.
+func ToFloat(value interface{}) (float64, error) {
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return float64(v.Uint()), nil
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), nil
+	case reflect.String:
+		f, err := strconv.ParseFloat(v.String(), 64)
+		if err != nil {
+			return 0, fmt.Errorf("validator: ToFloat: %w", err)
+		}
+		return f, nil
+	}
+
+	return 0, fmt.Errorf("validator: ToFloat: unsupported type %T", value)
+}
+
+// ToBoolean converts the string to a bool. It accepts the values of
+// strconv.ParseBool: 1, t, T, TRUE, true, True, 0, f, F, FALSE, false, False.
+func ToBoolean(str string) (bool, error) {
+	b, err := strconv.ParseBool(str)
+	if err != nil {
+		return false, fmt.Errorf("validator: ToBoolean: %w", err)
+	}
+
+	return b, nil
+}
+
+// ToJSON encodes obj as a JSON string.
+func ToJSON(obj interface{}) (string, error) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return "", fmt.Errorf("validator: ToJSON: %w", err)
+	}
+
+	return string(data), nil
+}
@@ -0,0 +1,115 @@
+package validator
+
+import (
+	"math"
+	"testing"
+)
+
+func TestToInt(t *testing.T) {
+	t.Parallel()
+
+	type myInt int8
+
+	var tests = []struct {
+		param    interface{}
+		expected int64
+		valid    bool
+	}{
+		{"1000", 1000, true},
+		{"-123", -123, true},
+		{"abcdef", 0, false},
+		{"100000000000000000000000000000000000000000000", 0, false},
+		{"0x10", 0, false},
+		{"", 0, false},
+		{42, 42, true},
+		{myInt(-7), -7, true},
+		{uint64(math.MaxInt64), math.MaxInt64, true},
+		{uint64(math.MaxUint64), 0, false},
+		{1.5, 0, false},
+		{nil, 0, false},
+	}
+	for _, test := range tests {
+		actual, err := ToInt(test.param)
+		if (err == nil) != test.valid || actual != test.expected {
+			t.Errorf("Expected ToInt(%#v) to be %v (valid %v), got %v, %v", test.param, test.expected, test.valid, actual, err)
+		}
+	}
+}
+
+func TestToFloat(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    interface{}
+		expected float64
+		valid    bool
+	}{
+		{"", 0, false},
+		{"123", 123, true},
+		{"-.01", -0.01, true},
+		{"10.", 10, true},
+		{"1e3", 1000, true},
+		{"string", 0, false},
+		{"1.23", 1.23, true},
+		{".23", 0.23, true},
+		{3, 3, true},
+		{uint8(255), 255, true},
+		{float32(0.5), 0.5, true},
+		{[]int{1}, 0, false},
+	}
+	for _, test := range tests {
+		actual, err := ToFloat(test.param)
+		if (err == nil) != test.valid || actual != test.expected {
+			t.Errorf("Expected ToFloat(%#v) to be %v (valid %v), got %v, %v", test.param, test.expected, test.valid, actual, err)
+		}
+	}
+}
+
+func TestToBoolean(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected bool
+		valid    bool
+	}{
+		{"true", true, true},
+		{"1", true, true},
+		{"True", true, true},
+		{"false", false, true},
+		{"0", false, true},
+		{"abcdef", false, false},
+		{"", false, false},
+	}
+	for _, test := range tests {
+		actual, err := ToBoolean(test.param)
+		if (err == nil) != test.valid || actual != test.expected {
+			t.Errorf("Expected ToBoolean(%q) to be %v (valid %v), got %v, %v", test.param, test.expected, test.valid, actual, err)
+		}
+	}
+}
+
+func TestToJSON(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    interface{}
+		expected string
+		valid    bool
+	}{
+		{"test", `"test"`, true},
+		{map[string]string{"a": "b", "b": "c"}, `{"a":"b","b":"c"}`, true},
+		{[]int{1, 2, 3}, `[1,2,3]`, true},
+		{struct {
+			Name string `json:"name"`
+		}{"x"}, `{"name":"x"}`, true},
+		{func() {}, "", false},
+		{math.NaN(), "", false},
+	}
+	for _, test := range tests {
+		actual, err := ToJSON(test.param)
+		if (err == nil) != test.valid || actual != test.expected {
+			t.Errorf("Expected ToJSON(%#v) to be %q (valid %v), got %q, %v", test.param, test.expected, test.valid, actual, err)
+		}
+	}
+}
@@ -0,0 +1,119 @@
+package validator
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// DisplayPolicy configures IsSafeDisplayText. The zero value is the strictest
+// policy: a single line of text without any invisible characters.
+type DisplayPolicy struct {
+	// AllowNewlines accepts "\n" and "\r".
+	AllowNewlines bool
+	// AllowTabs accepts "\t".
+	AllowTabs bool
+	// AllowJoiners accepts the zero-width joiner and non-joiner, which are
+	// required by emoji sequences and by scripts such as Persian or Devanagari.
+	AllowJoiners bool
+	// AllowBidiControls accepts bidirectional formatting characters as long
+	// as every embedding, override and isolate is closed on the same line.
+	AllowBidiControls bool
+}
+
+// IsSafeDisplayText checks if the string can be shown to other users without
+// hiding or reordering content: it must be valid UTF-8 without replacement
+// characters, control characters or invisible characters other than those
+// allowed by policy. Empty string is valid.
+func IsSafeDisplayText(str string, policy DisplayPolicy) bool {
+	if IsNull(str) {
+		return emptyStringValid()
+	}
+
+	var embeddings, isolates int
+	for _, c := range str {
+		switch {
+		case c == utf8.RuneError: // invalid UTF-8 or U+FFFD
+			return false
+		case c == '\n' || c == '\r':
+			if !policy.AllowNewlines || embeddings != 0 || isolates != 0 {
+				return false
+			}
+		case c == '\t':
+			if !policy.AllowTabs {
+				return false
+			}
+		case c == '\u200C' || c == '\u200D': // ZWNJ, ZWJ
+			if !policy.AllowJoiners {
+				return false
+			}
+		case isBidiControl(c):
+			if !policy.AllowBidiControls {
+				return false
+			}
+			switch c {
+			case '\u202A', '\u202B', '\u202D', '\u202E': // LRE, RLE, LRO, RLO
+				embeddings++
+			case '\u202C': // PDF
+				if embeddings--; embeddings < 0 {
+					return false
+				}
+			case '\u2066', '\u2067', '\u2068': // LRI, RLI, FSI
+				isolates++
+			case '\u2069': // PDI
+				if isolates--; isolates < 0 {
+					return false
+				}
+			}
+		case unicode.IsControl(c) || isInvisible(c):
+			return false
+		}
+	}
+
+	return embeddings == 0 && isolates == 0
+}
+
+// HasInvisibleChars checks if the string contains characters that occupy no
+// space when displayed, such as zero-width spaces and joiners, the byte order
+// mark, bidirectional controls, tag characters or Hangul fillers.
+func HasInvisibleChars(str string) bool {
+	for _, c := range str {
+		if isInvisible(c) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// HasBidiControls checks if the string contains bidirectional formatting
+// characters, such as the right-to-left override U+202E used by
+// "Trojan Source" attacks.
+func HasBidiControls(str string) bool {
+	for _, c := range str {
+		if isBidiControl(c) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isInvisible reports whether c is a format character or one of the
+// blank characters that render without a glyph.
+func isInvisible(c rune) bool {
+	switch c {
+	case '\u115F', '\u1160', '\u2800', '\u3164', '\uFFA0':
+		return true
+	}
+
+	return unicode.Is(unicode.Cf, c)
+}
+
+func isBidiControl(c rune) bool {
+	switch c {
+	case '\u061C', '\u200E', '\u200F': // ALM, LRM, RLM
+		return true
+	}
+
+	return ('\u202A' <= c && c <= '\u202E') || ('\u2066' <= c && c <= '\u2069')
+}
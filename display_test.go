@@ -0,0 +1,110 @@
+package validator
+
+import "testing"
+
+func TestIsSafeDisplayText(t *testing.T) {
+	t.Parallel()
+
+	multiline := DisplayPolicy{AllowNewlines: true, AllowTabs: true}
+	bidi := DisplayPolicy{AllowNewlines: true, AllowBidiControls: true}
+
+	var tests = []struct {
+		param    string
+		policy   DisplayPolicy
+		expected bool
+	}{
+		{"a\nb", DisplayPolicy{}, false},
+		{"a\tb", DisplayPolicy{}, false},
+		{"a\x00b", multiline, false},
+		{"a\x1bb", multiline, false},
+		{"a\u0085b", multiline, false},
+		{"\xff", DisplayPolicy{}, false},
+		{"\xed\xa0\x80", DisplayPolicy{}, false},
+		{"a\uFFFDb", DisplayPolicy{}, false},
+		{"a\u200Bb", DisplayPolicy{}, false},
+		{"\uFEFFabc", DisplayPolicy{}, false},
+		{"a\u2060b", DisplayPolicy{}, false},
+		{"a\u3164b", DisplayPolicy{}, false},
+		{"a\U000E0041b", DisplayPolicy{}, false},
+		{"👨\u200D👩", DisplayPolicy{}, false},
+		{"access\u202E\u2066 // admin\u2069\u2066", DisplayPolicy{}, false},
+		{"access\u202E\u2066 // admin\u2069\u2066", bidi, false},
+		{"\u202Eabc", bidi, false},
+		{"\u202Eabc\n\u202C", bidi, false},
+		{"abc\u202C", bidi, false},
+		{"\u2069abc\u2066", bidi, false},
+
+		{"", DisplayPolicy{}, true},
+		{"Hello, 世界!", DisplayPolicy{}, true},
+		{"a\nb\r\n\tc", multiline, true},
+		{"👨\u200D👩", DisplayPolicy{AllowJoiners: true}, true},
+		{"می\u200Cخواهم", DisplayPolicy{AllowJoiners: true}, true},
+		{"\u202Eabc\u202C", bidi, true},
+		{"\u2067שלום\u2069 world\n\u200Fa", bidi, true},
+	}
+	for _, test := range tests {
+		actual := IsSafeDisplayText(test.param, test.policy)
+		if actual != test.expected {
+			t.Errorf("Expected IsSafeDisplayText(%q, %+v) to be %v, got %v", test.param, test.policy, test.expected, actual)
+		}
+	}
+}
+
+func TestHasInvisibleChars(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"", false},
+		{"abc", false},
+		{"a b", false},
+		{"\t\n", false},
+		{"소주", false},
+
+		{"a\u200Bb", true},
+		{"a\u200Cb", true},
+		{"a\u200Db", true},
+		{"a\u00ADb", true},
+		{"\uFEFFabc", true},
+		{"a\u202Eb", true},
+		{"a\u115Fb", true},
+		{"\u2800", true},
+		{"a\U000E0041b", true},
+	}
+	for _, test := range tests {
+		actual := HasInvisibleChars(test.param)
+		if actual != test.expected {
+			t.Errorf("Expected HasInvisibleChars(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestHasBidiControls(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"", false},
+		{"abc", false},
+		{"שלום", false},
+		{"a\u200Bb", false},
+
+		{"a\u200Eb", true},
+		{"a\u200Fb", true},
+		{"a\u061Cb", true},
+		{"a\u202Ab", true},
+		{"a\u202Eb", true},
+		{"a\u2066b", true},
+		{"a\u2069b", true},
+	}
+	for _, test := range tests {
+		actual := HasBidiControls(test.param)
+		if actual != test.expected {
+			t.Errorf("Expected HasBidiControls(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+}
@@ -0,0 +1,136 @@
+package validator
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	// ErrRequired is the cause of the failures of the required rule.
+	ErrRequired = errors.New("validator: value is required")
+	// ErrInvalid is the cause of the failures of the other rules.
+	ErrInvalid = errors.New("validator: value is invalid")
+)
+
+// FieldError reports a field that failed a rule of ValidateStruct or of a
+// StringChain.
+type FieldError struct {
+	// Path locates the field, e.g. Items[3].Address.Zip.
+	Path string
+	// Pointer locates the field in the JSON form of the struct, as a JSON
+	// pointer (RFC 6901) built from json tag names, e.g. /items/3/address/zip.
+	Pointer string
+	// Rule is the name of the failed rule, e.g. required or length.
+	Rule string
+	// Param holds the parameters of the rule as written in the tag, e.g.
+	// "5|20" for length(5|20).
+	Param string
+	// Value is the offending value, or nil if it is not accessible. It may
+	// hold secrets such as passwords and is left out of the JSON encoding.
+	Value interface{}
+	// Err is ErrRequired or ErrInvalid.
+	Err error
+
+	// jsonPath locates the field in the JSON form of the struct, e.g.
+	// items[3].address.zip, for NewProblem.
+	jsonPath string
+}
+
+func newFieldError(loc fieldPath, rule, param string, value interface{}) *FieldError {
+	err := ErrInvalid
+	if rule == "required" {
+		err = ErrRequired
+	}
+
+	return &FieldError{Path: loc.path, Pointer: loc.pointer, Rule: rule, Param: param, Value: value, Err: err, jsonPath: loc.jsonPath}
+}
+
+func (e *FieldError) Error() string {
+	return "validator: " + e.message()
+}
+
+func (e *FieldError) message() string {
+	return e.messageAt(e.Path)
+}
+
+// messageAt describes the failure of the field at path.
+func (e *FieldError) messageAt(path string) string {
+	switch {
+	case e.Rule == "required":
+		return fmt.Sprintf("%s is required", path)
+	case e.Param != "":
+		return fmt.Sprintf("%s does not validate as %s(%s)", path, e.Rule, e.Param)
+	}
+
+	return fmt.Sprintf("%s does not validate as %s", path, e.Rule)
+}
+
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// MarshalJSON encodes the error as an object with the field, rule, param and
+// message members, omitting an empty param. The offending value is never
+// encoded, so that failures can be sent to clients without echoing secrets.
+func (e *FieldError) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Field   string `json:"field"`
+		Rule    string `json:"rule"`
+		Param   string `json:"param,omitempty"`
+		Message string `json:"message"`
+	}{e.Path, e.Rule, e.Param, e.message()})
+}
+
+// Errors holds the failures of a validation, in the order of the fields.
+type Errors []*FieldError
+
+func (es Errors) Error() string {
+	messages := make([]string, len(es))
+	for i, e := range es {
+		messages[i] = e.Error()
+	}
+
+	return strings.Join(messages, "\n")
+}
+
+// Unwrap returns the failures, so that errors.Is and errors.As inspect each
+// of them.
+func (es Errors) Unwrap() []error {
+	errs := make([]error, len(es))
+	for i, e := range es {
+		errs[i] = e
+	}
+
+	return errs
+}
+
+// GroupByField returns the failures of each field path.
+func (es Errors) GroupByField() map[string][]*FieldError {
+	groups := make(map[string][]*FieldError)
+	for _, e := range es {
+		groups[e.Path] = append(groups[e.Path], e)
+	}
+
+	return groups
+}
+
+// MarshalJSON encodes the failures as an array, empty rather than null when
+// there are none.
+func (es Errors) MarshalJSON() ([]byte, error) {
+	if es == nil {
+		return []byte("[]"), nil
+	}
+
+	return json.Marshal([]*FieldError(es))
+}
+
+// errOrNil returns es as an error, or nil if it is empty.
+func (es Errors) errOrNil() error {
+	if len(es) == 0 {
+		return nil
+	}
+
+	return es
+}
@@ -0,0 +1,113 @@
+package validator
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+type testErrorsOrder struct {
+	Email string `valid:"required,email"`
+	Items []struct {
+		Quantity int `valid:"min(1)"`
+		Address  struct {
+			Zip string `valid:"required,numeric,length(5|5)"`
+		}
+	}
+}
+
+func invalidTestOrder() testErrorsOrder {
+	var order testErrorsOrder
+	order.Items = make([]struct {
+		Quantity int `valid:"min(1)"`
+		Address  struct {
+			Zip string `valid:"required,numeric,length(5|5)"`
+		}
+	}, 4)
+	for i := range order.Items {
+		order.Items[i].Quantity = 1
+		order.Items[i].Address.Zip = "12345"
+	}
+	order.Items[3].Quantity = 0
+	order.Items[3].Address.Zip = "12a"
+
+	return order
+}
+
+func TestErrors(t *testing.T) {
+	t.Parallel()
+
+	err := ValidateStruct(invalidTestOrder())
+
+	var errs Errors
+	if !errors.As(err, &errs) {
+		t.Fatalf("Expected ValidateStruct to return Errors, got %T", err)
+	}
+	expected := Errors{
+		{Path: "Email", Pointer: "/Email", Rule: "required", Value: "", Err: ErrRequired, jsonPath: "Email"},
+		{Path: "Items[3].Quantity", Pointer: "/Items/3/Quantity", Rule: "min", Param: "1", Value: 0, Err: ErrInvalid, jsonPath: "Items[3].Quantity"},
+		{Path: "Items[3].Address.Zip", Pointer: "/Items/3/Address/Zip", Rule: "numeric", Value: "12a", Err: ErrInvalid, jsonPath: "Items[3].Address.Zip"},
+		{Path: "Items[3].Address.Zip", Pointer: "/Items/3/Address/Zip", Rule: "length", Param: "5|5", Value: "12a", Err: ErrInvalid, jsonPath: "Items[3].Address.Zip"},
+	}
+	if !reflect.DeepEqual(errs, expected) {
+		t.Errorf("Expected the errors to be %+v, got %+v", expected, errs)
+	}
+
+	if !errors.Is(err, ErrRequired) || !errors.Is(err, ErrInvalid) {
+		t.Errorf("Expected errors.Is to find ErrRequired and ErrInvalid in %v", err)
+	}
+	var fieldErr *FieldError
+	if !errors.As(err, &fieldErr) || fieldErr.Path != "Email" {
+		t.Errorf("Expected errors.As to find the first field error, got %v", fieldErr)
+	}
+	if joined := errors.Join(err, errors.New("other")); !errors.Is(joined, ErrRequired) {
+		t.Errorf("Expected errors.Is to see through errors.Join")
+	}
+
+	expectedMessage := "validator: Email is required\n" +
+		"validator: Items[3].Quantity does not validate as min(1)\n" +
+		"validator: Items[3].Address.Zip does not validate as numeric\n" +
+		"validator: Items[3].Address.Zip does not validate as length(5|5)"
+	if err.Error() != expectedMessage {
+		t.Errorf("Expected the message to be %q, got %q", expectedMessage, err.Error())
+	}
+
+	groups := errs.GroupByField()
+	if len(groups) != 3 || len(groups["Items[3].Address.Zip"]) != 2 || groups["Email"][0] != errs[0] {
+		t.Errorf("Expected the errors to be grouped by field, got %v", groups)
+	}
+
+	if ValidateStruct(testErrorsOrder{Email: "foo@bar.com"}) != nil {
+		t.Errorf("Expected a valid struct to return a nil error")
+	}
+}
+
+func TestErrorsMarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    Errors
+		expected string
+	}{
+		{nil, `[]`},
+		{Errors{}, `[]`},
+		{Errors{
+			newFieldError(fieldPath{path: "Email"}, "required", "", nil),
+			newFieldError(fieldPath{path: "Items[3].Quantity"}, "min", "1", 0),
+			newFieldError(fieldPath{path: "Zip"}, "numeric", "", "12a"),
+			newFieldError(fieldPath{path: "Password"}, "length", "8|64", "hunter2"),
+		}, `[` +
+			`{"field":"Email","rule":"required","message":"Email is required"},` +
+			`{"field":"Items[3].Quantity","rule":"min","param":"1","message":"Items[3].Quantity does not validate as min(1)"},` +
+			`{"field":"Zip","rule":"numeric","message":"Zip does not validate as numeric"},` +
+			`{"field":"Password","rule":"length","param":"8|64","message":"Password does not validate as length(8|64)"}` +
+			`]`},
+	}
+	for _, test := range tests {
+		actual, err := json.Marshal(test.param)
+		if err != nil || string(actual) != test.expected {
+			t.Errorf("Expected json.Marshal(%v) to be %s, got %s, %v", test.param, test.expected, actual, err)
+		}
+	}
+}
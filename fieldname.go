@@ -0,0 +1,41 @@
+package validator
+
+import (
+	"reflect"
+	"strings"
+)
+
+// FieldNameFunc returns the name of a struct field in the paths of
+// FieldError, or "" to keep its Go name.
+type FieldNameFunc func(field reflect.StructField) string
+
+// FieldNames returns an Option naming the fields in the paths of FieldError
+// with fn, e.g. FieldNames(TagName("json")) reports user_name rather than
+// UserName, including in nested paths such as items[3].zip_code.
+func FieldNames(fn FieldNameFunc) Option {
+	return func(w *structWalker) {
+		w.fieldName = fn
+	}
+}
+
+// TagName returns a FieldNameFunc naming fields after the struct tag tag, such
+// as json, form, query or xml. Options such as ",omitempty" are ignored, and
+// fields without a name in the tag, or whose tag is "-", keep their Go name.
+func TagName(tag string) FieldNameFunc {
+	return func(field reflect.StructField) string {
+		name, _ := tagFieldName(field, tag)
+		return name
+	}
+}
+
+// tagFieldName returns the name of field in the tag tag, or its Go name, and
+// whether the name comes from the tag.
+func tagFieldName(field reflect.StructField, tag string) (string, bool) {
+	value := field.Tag.Get(tag)
+	name, _, _ := strings.Cut(value, ",")
+	if name == "" || value == "-" {
+		return field.Name, false
+	}
+
+	return name, true
+}
@@ -0,0 +1,99 @@
+package validator
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type testFieldNameAddress struct {
+	ZipCode string `json:"zip_code,omitempty" form:"zip" query:"zip_q" xml:"zip,attr" valid:"required"`
+}
+
+type testFieldNameUser struct {
+	UserName  string                 `json:"user_name" form:"username" query:"u" xml:"name" valid:"required"`
+	Email     string                 `json:"-" form:"-" valid:"required"`
+	Dash      string                 `json:"-," valid:"required"`
+	Nickname  string                 `json:",omitempty" valid:"required"`
+	Addresses []testFieldNameAddress `json:"addresses" form:"addr" query:"a" xml:"address"`
+	Labels    map[string]testFieldNameAddress
+	testFieldNameEmbedded
+}
+
+type testFieldNameEmbedded struct {
+	Source string `json:"source" form:"src" valid:"required"`
+}
+
+func TestFieldNames(t *testing.T) {
+	t.Parallel()
+
+	user := testFieldNameUser{
+		Addresses: []testFieldNameAddress{{ZipCode: "12345"}, {}},
+		Labels:    map[string]testFieldNameAddress{"home": {}},
+	}
+
+	var tests = []struct {
+		opts     []Option
+		expected []string
+	}{
+		{nil, []string{
+			"UserName", "Email", "Dash", "Nickname", "Addresses[1].ZipCode", "Labels[home].ZipCode", "Source",
+		}},
+		{[]Option{FieldNames(TagName("json"))}, []string{
+			"user_name", "Email", "-", "Nickname", "addresses[1].zip_code", "Labels[home].zip_code", "source",
+		}},
+		{[]Option{FieldNames(TagName("form"))}, []string{
+			"username", "Email", "Dash", "Nickname", "addr[1].zip", "Labels[home].zip", "src",
+		}},
+		{[]Option{FieldNames(TagName("query"))}, []string{
+			"u", "Email", "Dash", "Nickname", "a[1].zip_q", "Labels[home].zip_q", "Source",
+		}},
+		{[]Option{FieldNames(TagName("xml"))}, []string{
+			"name", "Email", "Dash", "Nickname", "address[1].zip", "Labels[home].zip", "Source",
+		}},
+		{[]Option{FieldNames(func(field reflect.StructField) string {
+			return strings.ToLower(field.Name)
+		})}, []string{
+			"username", "email", "dash", "nickname", "addresses[1].zipcode", "labels[home].zipcode", "source",
+		}},
+	}
+	for _, test := range tests {
+		var errs Errors
+		if !errors.As(ValidateStruct(user, test.opts...), &errs) {
+			t.Fatalf("Expected the user to be invalid")
+		}
+
+		var actual []string
+		for _, e := range errs {
+			actual = append(actual, e.Path)
+		}
+		if !reflect.DeepEqual(actual, test.expected) {
+			t.Errorf("Expected the paths to be %q, got %q", test.expected, actual)
+		}
+		if errs[4].Pointer != "/addresses/1/zip_code" {
+			t.Errorf("Expected the pointers to use json names, got %q", errs[4].Pointer)
+		}
+	}
+
+	var tagged struct {
+		testFieldNameEmbedded `json:"meta"`
+	}
+	var fieldErr *FieldError
+	if err := ValidateStruct(tagged, FieldNames(TagName("json"))); !errors.As(err, &fieldErr) ||
+		fieldErr.Path != "meta.source" || fieldErr.Pointer != "/meta/source" {
+		t.Errorf("Expected embedded structs named by a json tag to be nested, got %v", err)
+	}
+
+	err := ValidateAndSanitize(&testFieldNameAddress{}, FieldNames(TagName("json")))
+	if err == nil || err.Error() != "validator: zip_code is required" {
+		t.Errorf("Expected ValidateAndSanitize to use json names, got %v", err)
+	}
+
+	err = ValidateStruct(struct {
+		Age int `json:"age" valid:"alpha"`
+	}{}, FieldNames(TagName("json")))
+	if err == nil || !strings.Contains(err.Error(), "validator: age: ") {
+		t.Errorf("Expected usage errors to use json names, got %v", err)
+	}
+}
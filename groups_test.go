@@ -0,0 +1,80 @@
+package validator
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestGroups(t *testing.T) {
+	t.Parallel()
+
+	type address struct {
+		Zip string `valid:"required~create,numeric"`
+	}
+	type user struct {
+		ID      string `valid:"required~update~delete,numeric"`
+		Email   string `valid:"required~create,email"`
+		Name    string `valid:"required~create~import,runelength(1|5)~create"`
+		Code    string `valid:"matches(^a~b$)~create"`
+		Address address
+	}
+
+	var tests = []struct {
+		param    user
+		opts     []Option
+		expected []string
+	}{
+		{user{}, nil, nil},
+		{user{Email: "foo", Name: "abcdefg", Code: "x"}, nil, []string{
+			"validator: Email does not validate as email",
+		}},
+		{user{}, []Option{Groups("create")}, []string{
+			"validator: Email is required",
+			"validator: Name is required",
+			"validator: Address.Zip is required",
+		}},
+		{user{Email: "foo@bar.com", Name: "abcdefg", Code: "x", Address: address{Zip: "1"}}, []Option{Groups("create")}, []string{
+			"validator: Name does not validate as runelength(1|5)",
+			"validator: Code does not validate as matches(^a~b$)",
+		}},
+		{user{Email: "foo@bar.com", Name: "ab", Code: "a~b", Address: address{Zip: "1"}}, []Option{Groups("create")}, nil},
+		{user{}, []Option{Groups("update")}, []string{
+			"validator: ID is required",
+		}},
+		{user{ID: "x", Name: "abcdefg"}, []Option{Groups("import")}, []string{
+			"validator: ID does not validate as numeric",
+		}},
+		{user{}, []Option{Groups("update", "import")}, []string{
+			"validator: ID is required",
+			"validator: Name is required",
+		}},
+		{user{}, []Option{Groups("delete"), Groups("create")}, []string{
+			"validator: ID is required",
+			"validator: Email is required",
+			"validator: Name is required",
+			"validator: Address.Zip is required",
+		}},
+	}
+	for _, test := range tests {
+		actual := errorMessages(ValidateStruct(test.param, test.opts...))
+		if !reflect.DeepEqual(actual, test.expected) {
+			t.Errorf("Expected ValidateStruct(%+v) to fail with %q, got %q", test.param, test.expected, actual)
+		}
+	}
+
+	var sanitized struct {
+		Email string `valid:"required~create,email" sanitize:"trim"`
+	}
+	sanitized.Email = "  "
+	if err := ValidateAndSanitize(&sanitized, Groups("create")); err == nil || err.Error() != "validator: Email is required" {
+		t.Errorf("Expected ValidateAndSanitize to apply groups, got %v", err)
+	}
+
+	err := ValidateStruct(struct {
+		Name string `valid:"required~"`
+	}{})
+	if err == nil || !strings.Contains(err.Error(), `Name: empty group in rule "required"`) {
+		t.Errorf("Expected an empty group to be rejected, got %v", err)
+	}
+}
@@ -0,0 +1,33 @@
+package validator
+
+import (
+	"errors"
+	"strings"
+)
+
+// ErrUnknownLocale is returned when no data is embedded for a locale.
+var ErrUnknownLocale = errors.New("validator: unknown locale")
+
+// lookupLocale finds the entry of m for a BCP 47 locale such as "de-DE" or
+// "pt_BR", falling back to its language ("de", "pt").
+func lookupLocale[T any](m map[string]T, locale string) (T, bool) {
+	locale = normalizeLocale(locale)
+	if v, ok := m[locale]; ok {
+		return v, true
+	}
+
+	lang, _, _ := strings.Cut(locale, "-")
+	v, ok := m[lang]
+	return v, ok
+}
+
+// normalizeLocale writes a locale as the keys of locale data: "pt_br" becomes
+// "pt-BR".
+func normalizeLocale(locale string) string {
+	lang, region, hasRegion := strings.Cut(strings.ReplaceAll(locale, "_", "-"), "-")
+	if !hasRegion || region == "" {
+		return strings.ToLower(lang)
+	}
+
+	return strings.ToLower(lang) + "-" + strings.ToUpper(region)
+}
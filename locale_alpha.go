@@ -0,0 +1,133 @@
+package validator
+
+import "unicode"
+
+// alphabet reports whether a rune is a letter of a locale.
+type alphabet func(c rune) bool
+
+// letterSet returns the alphabet made of letters and their upper and lower case forms.
+func letterSet(letters string) alphabet {
+	set := make(map[rune]bool, 2*len(letters))
+	for _, c := range letters {
+		set[c] = true
+		set[unicode.ToUpper(c)] = true
+		set[unicode.ToLower(c)] = true
+	}
+
+	return func(c rune) bool {
+		return set[c]
+	}
+}
+
+// scriptSet returns the alphabet made of the letters and combining marks of
+// the scripts, plus the extra characters. Combining marks shared by several
+// scripts, such as the Arabic harakat, are accepted too.
+func scriptSet(extra string, scripts ...*unicode.RangeTable) alphabet {
+	extras := letterSet(extra)
+	return func(c rune) bool {
+		switch {
+		case extras(c):
+			return true
+		case unicode.IsMark(c):
+			return unicode.IsOneOf(scripts, c) || unicode.Is(unicode.Inherited, c)
+		default:
+			return unicode.IsLetter(c) && unicode.IsOneOf(scripts, c)
+		}
+	}
+}
+
+const latinLetters = "abcdefghijklmnopqrstuvwxyz"
+
+// localeAlphabets holds the letters of common locales, following the
+// locale data of validator.js where available.
+var localeAlphabets = map[string]alphabet{
+	"en": letterSet(latinLetters),
+	"de": letterSet(latinLetters + "äöüßẞ"),
+	"fr": letterSet(latinLetters + "àâæçéèêëïîôœùûüÿ"),
+	"es": letterSet(latinLetters + "áéñóúüí"),
+	"it": letterSet(latinLetters + "àéèìîóòù"),
+	"pt": letterSet(latinLetters + "ãáàâäçéêëíïõóôöúü"),
+	"nl": letterSet(latinLetters + "áéëïóöüú"),
+	"sv": letterSet(latinLetters + "åäö"),
+	"da": letterSet(latinLetters + "æøå"),
+	"nb": letterSet(latinLetters + "æøå"),
+	"fi": letterSet(latinLetters + "åäö"),
+	"pl": letterSet(latinLetters + "ąćęśłńóżź"),
+	"cs": letterSet(latinLetters + "áčďéěíňóřšťúůýž"),
+	"sk": letterSet(latinLetters + "áäčďéíĺľňóôŕšťúýž"),
+	"hu": letterSet(latinLetters + "áéíóöőúüű"),
+	"ro": letterSet(latinLetters + "ăâîșțşţ"),
+	"tr": letterSet(latinLetters + "çğıİöşü"),
+	"ru": letterSet("абвгдеёжзийклмнопрстуфхцчшщъыьэюя"),
+	"uk": letterSet("абвгґдеєжзиіїйклмнопрстуфхцчшщьюя"),
+	"bg": letterSet("абвгдежзийклмнопрстуфхцчшщъьюя"),
+	"el": letterSet("αβγδεζηθικλμνξοπρσςτυφχψωάέήίόύώϊϋΐΰ"),
+	"ar": scriptSet("", unicode.Arabic),
+	"fa": scriptSet("", unicode.Arabic),
+	"he": letterSet("אבגדהוזחטיךכלםמןנסעףפץצקרשת"),
+	"hi": scriptSet("", unicode.Devanagari),
+	"zh": scriptSet("", unicode.Han),
+	"ja": scriptSet("ー", unicode.Han, unicode.Hiragana, unicode.Katakana),
+	"ko": scriptSet("", unicode.Hangul),
+}
+
+// IsAlphaLocale checks if the string contains only letters of the alphabet
+// of locale, e.g. "de-DE" accepts "Straße" and "pl-PL" accepts "Łódź".
+// Unknown locales are rejected. Empty string is valid.
+func IsAlphaLocale(str, locale string) bool {
+	if IsNull(str) {
+		return emptyStringValid()
+	}
+
+	letters, ok := lookupLocale(localeAlphabets, locale)
+	if !ok {
+		return false
+	}
+
+	for _, c := range str {
+		if !letters(c) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsAlphanumericLocale checks if the string contains only letters of the
+// alphabet of locale and digits, including the native digits of locales
+// such as "ar" or "hi-IN". Unknown locales are rejected. Empty string is valid.
+func IsAlphanumericLocale(str, locale string) bool {
+	if IsNull(str) {
+		return emptyStringValid()
+	}
+
+	letters, ok := lookupLocale(localeAlphabets, locale)
+	if !ok {
+		return false
+	}
+
+	zeros := []rune{latnZero}
+	if format, ok := lookupLocale(localeNumberFormats, locale); ok {
+		zeros = format.zeros
+	}
+	for _, c := range str {
+		if letters(c) {
+			continue
+		}
+		if !isLocaleDigit(c, zeros) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isLocaleDigit(c rune, zeros []rune) bool {
+	for _, zero := range zeros {
+		if zero <= c && c <= zero+9 {
+			return true
+		}
+	}
+
+	return false
+}
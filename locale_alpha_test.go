@@ -0,0 +1,79 @@
+package validator
+
+import "testing"
+
+func TestIsAlphaLocale(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		locale   string
+		expected bool
+	}{
+		{"Straße", "en-US", false},
+		{"abc1", "en-US", false},
+		{"Łódź", "de-DE", false},
+		{"hello world", "en-US", false},
+		{"Привет", "pl-PL", false},
+		{"ё", "bg-BG", false},
+		{"漢字", "ko-KR", false},
+		{"abc", "xx-YY", false},
+		{"مرحبا١", "ar", false},
+
+		{"", "en-US", true},
+		{"", "xx-YY", true},
+		{"Hello", "en-US", true},
+		{"Straße", "de-DE", true},
+		{"ÄÖÜ", "de", true},
+		{"Noël", "fr-FR", true},
+		{"Mañana", "es-ES", true},
+		{"Łódź", "pl-PL", true},
+		{"İstanbul", "tr-TR", true},
+		{"ПриветЁж", "ru-RU", true},
+		{"Їжак", "uk_UA", true},
+		{"Καλημέρα", "el-GR", true},
+		{"مَرحبا", "ar", true},
+		{"שלום", "he", true},
+		{"नमस्ते", "hi-IN", true},
+		{"汉字", "zh-CN", true},
+		{"ひらがなカタカナ漢字ー", "ja-JP", true},
+		{"한국어", "ko-KR", true},
+	}
+	for _, test := range tests {
+		actual := IsAlphaLocale(test.param, test.locale)
+		if actual != test.expected {
+			t.Errorf("Expected IsAlphaLocale(%q, %q) to be %v, got %v", test.param, test.locale, test.expected, actual)
+		}
+	}
+}
+
+func TestIsAlphanumericLocale(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		locale   string
+		expected bool
+	}{
+		{"abc-1", "en-US", false},
+		{"Straße١", "de-DE", false},
+		{"मुंबई१२x", "hi-IN", false},
+		{"abc1", "xx-YY", false},
+
+		{"", "en-US", true},
+		{"abc123", "en-US", true},
+		{"Straße12", "de-DE", true},
+		{"Łódź2024", "pl-PL", true},
+		{"شارع١٢", "ar", true},
+		{"شارع12", "ar-EG", true},
+		{"خیابان۱۲", "fa-IR", true},
+		{"मुंबई१२", "hi-IN", true},
+		{"東京2024", "ja-JP", true},
+	}
+	for _, test := range tests {
+		actual := IsAlphanumericLocale(test.param, test.locale)
+		if actual != test.expected {
+			t.Errorf("Expected IsAlphanumericLocale(%q, %q) to be %v, got %v", test.param, test.locale, test.expected, actual)
+		}
+	}
+}
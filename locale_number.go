@@ -0,0 +1,215 @@
+package validator
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// numberFormat describes how a locale writes numbers.
+type numberFormat struct {
+	decimal rune
+	// groups lists the accepted grouping separators.
+	groups []rune
+	// indian selects the 3;2 grouping of South Asia (1,23,456) instead of
+	// groups of three.
+	indian bool
+	// zeros lists the zero digit of every accepted digit system; the other
+	// digits follow it contiguously.
+	zeros []rune
+}
+
+const (
+	latnZero    = '0'
+	arabZero    = '٠' // Arabic-Indic
+	arabextZero = '۰' // Extended Arabic-Indic
+	devaZero    = '०' // Devanagari
+	bengZero    = '০' // Bengali
+	thaiZero    = '๐' // Thai
+)
+
+var (
+	numPointComma = numberFormat{decimal: '.', groups: []rune{','}, zeros: []rune{latnZero}}
+	numCommaPoint = numberFormat{decimal: ',', groups: []rune{'.'}, zeros: []rune{latnZero}}
+	numCommaSpace = numberFormat{decimal: ',', groups: []rune{'\u00A0', '\u202F', ' '}, zeros: []rune{latnZero}}
+	numArabic     = numberFormat{decimal: '٫', groups: []rune{'٬'}, zeros: []rune{arabZero, latnZero}}
+	numIndian     = numberFormat{decimal: '.', groups: []rune{','}, indian: true, zeros: []rune{latnZero, devaZero}}
+)
+
+// localeNumberFormats holds the number symbols of common locales, from CLDR.
+var localeNumberFormats = map[string]numberFormat{
+	"en":    numPointComma,
+	"en-IN": {decimal: '.', groups: []rune{','}, indian: true, zeros: []rune{latnZero}},
+	"zh":    numPointComma,
+	"ja":    numPointComma,
+	"ko":    numPointComma,
+	"th":    {decimal: '.', groups: []rune{','}, zeros: []rune{latnZero, thaiZero}},
+	"he":    numPointComma,
+	"ms":    numPointComma,
+
+	"de":    numCommaPoint,
+	"de-AT": numCommaSpace,
+	"de-CH": {decimal: '.', groups: []rune{'’', '\''}, zeros: []rune{latnZero}},
+	"es":    numCommaPoint,
+	"es-MX": numPointComma,
+	"it":    numCommaPoint,
+	"nl":    numCommaPoint,
+	"pt":    numCommaPoint,
+	"pt-PT": numCommaSpace,
+	"id":    numCommaPoint,
+	"tr":    numCommaPoint,
+	"da":    numCommaPoint,
+	"el":    numCommaPoint,
+	"ro":    numCommaPoint,
+
+	"fr": numCommaSpace,
+	"ru": numCommaSpace,
+	"uk": numCommaSpace,
+	"pl": numCommaSpace,
+	"cs": numCommaSpace,
+	"sk": numCommaSpace,
+	"hu": numCommaSpace,
+	"sv": numCommaSpace,
+	"fi": numCommaSpace,
+	"nb": numCommaSpace,
+	"bg": numCommaSpace,
+
+	"ar":    numArabic,
+	"ar-MA": {decimal: ',', groups: []rune{'.'}, zeros: []rune{latnZero}},
+	"fa":    {decimal: '٫', groups: []rune{'٬'}, zeros: []rune{arabextZero, latnZero}},
+	"ur":    {decimal: '.', groups: []rune{','}, zeros: []rune{latnZero, arabextZero}},
+	"hi":    numIndian,
+	"mr":    {decimal: '.', groups: []rune{','}, indian: true, zeros: []rune{devaZero, latnZero}},
+	"ne":    {decimal: '.', groups: []rune{','}, indian: true, zeros: []rune{devaZero, latnZero}},
+	"bn":    {decimal: '.', groups: []rune{','}, indian: true, zeros: []rune{bengZero, latnZero}},
+}
+
+// IsLocaleNumber checks if the string is a number as written in locale, e.g.
+// "1.234,56" in "de-DE" or "1,23,456.78" in "en-IN". Grouping separators are
+// optional but, when present, must form valid groups. Digits of the locale's
+// native digit system are accepted, but may not be mixed with other digits.
+// Unknown locales are rejected. Empty string is valid.
+func IsLocaleNumber(str, locale string) bool {
+	if IsNull(str) {
+		return emptyStringValid()
+	}
+
+	_, err := ParseLocaleNumber(str, locale)
+	return err == nil
+}
+
+// ParseLocaleNumber parses a number written as in locale, see IsLocaleNumber.
+// It returns an error wrapping ErrUnknownLocale for locales without data.
+func ParseLocaleNumber(str, locale string) (float64, error) {
+	format, ok := lookupLocale(localeNumberFormats, locale)
+	if !ok {
+		return 0, fmt.Errorf("%w: %q", ErrUnknownLocale, locale)
+	}
+
+	normalized, ok := normalizeLocaleNumber(str, format)
+	if !ok {
+		return 0, fmt.Errorf("validator: %q is not a number in locale %q", str, locale)
+	}
+
+	return strconv.ParseFloat(normalized, 64)
+}
+
+// normalizeLocaleNumber rewrites str with ASCII digits, no grouping and "."
+// as decimal separator.
+func normalizeLocaleNumber(str string, format numberFormat) (string, bool) {
+	var b strings.Builder
+	if c, size := utf8.DecodeRuneInString(str); c == '-' || c == '+' || c == '\u2212' {
+		if c == '\u2212' {
+			c = '-'
+		}
+		b.WriteRune(c)
+		str = str[size:]
+	}
+
+	integer, fraction, hasFraction := strings.Cut(str, string(format.decimal))
+	if integer == "" && fraction == "" {
+		return "", false
+	}
+
+	zero := rune(-1)
+	toASCII := func(digits string) bool {
+		for _, c := range digits {
+			if zero < 0 {
+				for _, z := range format.zeros {
+					if z <= c && c <= z+9 {
+						zero = z
+					}
+				}
+			}
+			if zero < 0 || c < zero || c > zero+9 {
+				return false
+			}
+			b.WriteRune('0' + c - zero)
+		}
+
+		return true
+	}
+
+	groups := splitGroups(integer, format.groups)
+	if !validGroups(groups, format.indian) {
+		return "", false
+	}
+	for _, group := range groups {
+		if !toASCII(group) {
+			return "", false
+		}
+	}
+	if hasFraction {
+		b.WriteByte('.')
+		if !toASCII(fraction) {
+			return "", false
+		}
+	}
+
+	return b.String(), true
+}
+
+// splitGroups splits the integer part of a number at any of the separators.
+func splitGroups(integer string, separators []rune) []string {
+	groups := []string{}
+	start := 0
+	for i, c := range integer {
+		for _, sep := range separators {
+			if c == sep {
+				groups = append(groups, integer[start:i])
+				start = i + utf8.RuneLen(c)
+				break
+			}
+		}
+	}
+
+	return append(groups, integer[start:])
+}
+
+// validGroups checks the sizes of the digit groups of the integer part: a
+// single group of any size, or groups of three (or of two followed by a final
+// group of three for Indian grouping) after a leading group of at most the
+// same size.
+func validGroups(groups []string, indian bool) bool {
+	if len(groups) <= 1 {
+		return true
+	}
+
+	for i := len(groups) - 1; i >= 0; i-- {
+		size := 3
+		if indian && i < len(groups)-1 {
+			size = 2
+		}
+
+		n := utf8.RuneCountInString(groups[i])
+		if i == 0 {
+			return n >= 1 && n <= size
+		}
+		if n != size {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,87 @@
+package validator
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestIsLocaleNumber(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		locale   string
+		expected bool
+	}{
+		{"1.234,56", "en-US", false},
+		{"1,23,456.78", "en-US", false},
+		{"1,234.56", "de-DE", false},
+		{"12.34.567", "de-DE", false},
+		{"1.2345", "de", false},
+		{",", "de-DE", false},
+		{"-", "en-US", false},
+		{"--1", "en-US", false},
+		{"1,,000", "en-US", false},
+		{"1,000,", "en-US", false},
+		{"1,234,56", "en-IN", false},
+		{"١٢3", "ar", false},
+		{"१२३", "en-IN", false},
+		{"abc", "en-US", false},
+		{"1 000", "xx-YY", false},
+
+		{"", "en-US", true},
+		{"1234.5", "en-US", true},
+		{"1,234.5", "en", true},
+		{"-1,234,567.89", "en-GB", true},
+		{".5", "en-US", true},
+		{"1.234,56", "de-DE", true},
+		{"1.234,56", "de_DE", true},
+		{"1’234.56", "de-CH", true},
+		{"1 234,56", "fr-FR", true},
+		{"1\u00A0234,56", "fr-FR", true},
+		{"1\u202F234,56", "ru-RU", true},
+		{"1,23,456.78", "en-IN", true},
+		{"12,34,56,789", "hi-IN", true},
+		{"१२,३४,५६७.८९", "hi-IN", true},
+		{"١٬٢٣٤٫٥٦", "ar-EG", true},
+		{"1٬234٫5", "ar", true},
+		{"۱۲۳٫۴", "fa-IR", true},
+		{"−5", "en-US", true},
+	}
+	for _, test := range tests {
+		actual := IsLocaleNumber(test.param, test.locale)
+		if actual != test.expected {
+			t.Errorf("Expected IsLocaleNumber(%q, %q) to be %v, got %v", test.param, test.locale, test.expected, actual)
+		}
+	}
+}
+
+func TestParseLocaleNumber(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		locale   string
+		expected float64
+	}{
+		{"1,234.5", "en-US", 1234.5},
+		{"1.234,56", "de-DE", 1234.56},
+		{"1 234,5", "fr", 1234.5},
+		{"1,23,456.78", "en-IN", 123456.78},
+		{"-१२,३४५", "hi-IN", -12345},
+		{"١٬٢٣٤٫٥", "ar-EG", 1234.5},
+	}
+	for _, test := range tests {
+		actual, err := ParseLocaleNumber(test.param, test.locale)
+		if err != nil || actual != test.expected {
+			t.Errorf("Expected ParseLocaleNumber(%q, %q) to be %v, got %v (%v)", test.param, test.locale, test.expected, actual, err)
+		}
+	}
+
+	if _, err := ParseLocaleNumber("1", "xx"); !errors.Is(err, ErrUnknownLocale) {
+		t.Errorf("Expected ParseLocaleNumber with an unknown locale to fail with ErrUnknownLocale, got %v", err)
+	}
+	if _, err := ParseLocaleNumber("1,2", "en"); err == nil {
+		t.Error("Expected ParseLocaleNumber(\"1,2\", \"en\") to fail")
+	}
+}
@@ -0,0 +1,75 @@
+package validator
+
+import "unicode"
+
+// NumericOptions describes the characters accepted around the digits by
+// IsNumericWith, IsUTFDigitWith and IsUTFNumericWith.
+type NumericOptions struct {
+	// AllowSign accepts a single leading "+" or "-".
+	AllowSign bool
+	// AllowDecimal accepts a single DecimalSeparator.
+	AllowDecimal bool
+	// AllowGrouping accepts GroupSeparator between two digits of the
+	// integer part, e.g. "1,000". Group sizes are not checked.
+	AllowGrouping bool
+	// AllowNoDigit accepts non-empty strings without any digit, such as "-"
+	// or ".", which are rejected by default.
+	AllowNoDigit bool
+
+	// DecimalSeparator defaults to '.' and GroupSeparator to ','.
+	// They must differ.
+	DecimalSeparator rune
+	GroupSeparator   rune
+}
+
+// IsNumericWith checks if the string contains only ASCII digits and the
+// separators allowed by opts. Empty string is valid.
+func IsNumericWith(str string, opts NumericOptions) bool {
+	return isNumberWith(str, opts, func(c rune) bool {
+		return '0' <= c && c <= '9'
+	})
+}
+
+// IsUTFDigitWith checks if the string contains only unicode radix-10 decimal
+// digits and the separators allowed by opts. Empty string is valid.
+func IsUTFDigitWith(str string, opts NumericOptions) bool {
+	return isNumberWith(str, opts, unicode.IsDigit)
+}
+
+// IsUTFNumericWith checks if the string contains only unicode numbers of any
+// kind and the separators allowed by opts. Empty string is valid.
+func IsUTFNumericWith(str string, opts NumericOptions) bool {
+	return isNumberWith(str, opts, unicode.IsNumber)
+}
+
+func isNumberWith(str string, opts NumericOptions, isDigit func(rune) bool) bool {
+	if IsNull(str) {
+		return emptyStringValid()
+	}
+
+	decimal, group := opts.DecimalSeparator, opts.GroupSeparator
+	if decimal == 0 {
+		decimal = '.'
+	}
+	if group == 0 {
+		group = ','
+	}
+
+	runes := []rune(str)
+	digits, seenDecimal := 0, false
+	for i, c := range runes {
+		switch {
+		case isDigit(c):
+			digits++
+		case opts.AllowSign && i == 0 && (c == '+' || c == '-'):
+		case opts.AllowDecimal && c == decimal && !seenDecimal:
+			seenDecimal = true
+		case opts.AllowGrouping && c == group && !seenDecimal &&
+			i > 0 && isDigit(runes[i-1]) && i+1 < len(runes) && isDigit(runes[i+1]):
+		default:
+			return false
+		}
+	}
+
+	return digits > 0 || opts.AllowNoDigit
+}
@@ -0,0 +1,104 @@
+package validator
+
+import "testing"
+
+func TestIsNumericWith(t *testing.T) {
+	t.Parallel()
+
+	signed := NumericOptions{AllowSign: true}
+	decimal := NumericOptions{AllowSign: true, AllowDecimal: true, AllowGrouping: true}
+	european := NumericOptions{AllowDecimal: true, AllowGrouping: true, DecimalSeparator: ',', GroupSeparator: '.'}
+
+	var tests = []struct {
+		param    string
+		opts     NumericOptions
+		expected bool
+	}{
+		{"-1", NumericOptions{}, false},
+		{"1.5", NumericOptions{}, false},
+		{"-", signed, false},
+		{"+", signed, false},
+		{"--1", signed, false},
+		{"+-1", signed, false},
+		{"1-", signed, false},
+		{"۳۵", signed, false},
+		{".", decimal, false},
+		{"-", NumericOptions{AllowSign: true}, false},
+		{".", NumericOptions{AllowDecimal: true}, false},
+		{"1.2.3", decimal, false},
+		{",100", decimal, false},
+		{"100,", decimal, false},
+		{"1,,000", decimal, false},
+		{"1.000,5", decimal, false},
+		{"1,000.5", european, false},
+
+		{"", NumericOptions{}, true},
+		{"0123", NumericOptions{}, true},
+		{"-", NumericOptions{AllowSign: true, AllowNoDigit: true}, true},
+		{".", NumericOptions{AllowDecimal: true, AllowNoDigit: true}, true},
+		{"-1", signed, true},
+		{"+1", signed, true},
+		{"-1.5", decimal, true},
+		{".5", decimal, true},
+		{"5.", decimal, true},
+		{"1,000,000.25", decimal, true},
+		{"1,00,000", decimal, true},
+		{"1.000,5", european, true},
+	}
+	for _, test := range tests {
+		actual := IsNumericWith(test.param, test.opts)
+		if actual != test.expected {
+			t.Errorf("Expected IsNumericWith(%q, %+v) to be %v, got %v", test.param, test.opts, test.expected, actual)
+		}
+	}
+}
+
+func TestIsUTFDigitWith(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		opts     NumericOptions
+		expected bool
+	}{
+		{"-", NumericOptions{AllowSign: true}, false},
+		{"-۳۵", NumericOptions{}, false},
+		{"1¾", NumericOptions{AllowSign: true}, false},
+		{"۳۵.۶", NumericOptions{}, false},
+
+		{"-", NumericOptions{AllowSign: true, AllowNoDigit: true}, true},
+		{"-۳۵", NumericOptions{AllowSign: true}, true},
+		{"۳۵.۶", NumericOptions{AllowDecimal: true}, true},
+		{"१,२३४", NumericOptions{AllowGrouping: true}, true},
+	}
+	for _, test := range tests {
+		actual := IsUTFDigitWith(test.param, test.opts)
+		if actual != test.expected {
+			t.Errorf("Expected IsUTFDigitWith(%q, %+v) to be %v, got %v", test.param, test.opts, test.expected, actual)
+		}
+	}
+}
+
+func TestIsUTFNumericWith(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		opts     NumericOptions
+		expected bool
+	}{
+		{"+", NumericOptions{AllowSign: true}, false},
+		{"-1¾", NumericOptions{}, false},
+		{"Ⅸ.5", NumericOptions{}, false},
+
+		{"+", NumericOptions{AllowSign: true, AllowNoDigit: true}, true},
+		{"-1¾", NumericOptions{AllowSign: true}, true},
+		{"Ⅸ.5", NumericOptions{AllowDecimal: true}, true},
+	}
+	for _, test := range tests {
+		actual := IsUTFNumericWith(test.param, test.opts)
+		if actual != test.expected {
+			t.Errorf("Expected IsUTFNumericWith(%q, %+v) to be %v, got %v", test.param, test.opts, test.expected, actual)
+		}
+	}
+}
@@ -40,7 +40,8 @@ const (
 	tagName           string = "valid"
 	UnixARPath        string = `^((\.{0,2}/)?([^/\x00]*))+/?$`
 	UnixPath          string = `^(/[^/\x00]*)+/?$`
-	URL               string = `^` + URLSchema + `?` + URLUsername + `?` + `((` + URLIP + `|(\[` + IP + `\])|(([a-zA-Z0-9]([a-zA-Z0-9-_]+)?[a-zA-Z0-9]([-\.][a-zA-Z0-9]+)*)|(` + URLSubdomain + `?))?(([a-zA-Z\x{00a1}-\x{ffff}0-9]+-?-?)*[a-zA-Z\x{00a1}-\x{ffff}0-9]+)(?:\.([a-zA-Z\x{00a1}-\x{ffff}]{1,}))?))\.?` + URLPort + `?` + URLPath + `?$`
+	URL               string = `^` + URLSchema + `?` + URLUsername + `?` + URLHost + `\.?` + URLPort + `?` + URLPath + `?$`
+	URLHost           string = `((` + URLIP + `|(\[` + IP + `\])|(([a-zA-Z0-9]([a-zA-Z0-9-_]+)?[a-zA-Z0-9]([-\.][a-zA-Z0-9]+)*)|(` + URLSubdomain + `?))?(([a-zA-Z\x{00a1}-\x{ffff}0-9]+-?-?)*[a-zA-Z\x{00a1}-\x{ffff}0-9]+)(?:\.([a-zA-Z\x{00a1}-\x{ffff}]{1,}))?))`
 	URLIP             string = `([1-9]\d?|1\d\d|2[01]\d|22[0-3]|24\d|25[0-5])(\.(\d{1,2}|1\d\d|2[0-4]\d|25[0-5])){2}(?:\.([0-9]\d?|1\d\d|2[0-4]\d|25[0-5]))`
 	URLPath           string = `((\/|\?|#)[^\s]*)`
 	URLPort           string = `(:(\d{1,5}))`
@@ -69,7 +70,6 @@ var (
 	alphaRegexp        = regexp.MustCompile(Alpha)
 	alphanumericRegexp = regexp.MustCompile(Alphanumeric)
 	emailRegexp        = regexp.MustCompile(Email)
-	numericRegexp      = regexp.MustCompile(Numeric)
 	urlRegexp          = regexp.MustCompile(URL)
 	userDotRegexp      = regexp.MustCompile("(^[.]{1})|([.]{1}$)|([.]{2,})")
 	userRegexp         = regexp.MustCompile("^[a-zA-Z0-9!#$%&'*+/=?^_`{|}~.-]+$")
@@ -111,4 +111,10 @@ var (
 	rxIMEI              = regexp.MustCompile(IMEI)
 	rxIMSI              = regexp.MustCompile(IMSI)
 	rxE164              = regexp.MustCompile(E164)
+	rxURLSchema         = regexp.MustCompile("^" + URLSchema + "$")
+	rxURLUsername       = regexp.MustCompile("^" + URLUsername + "$")
+	rxURLHost           = regexp.MustCompile("^" + URLHost + `\.?$`)
+	rxURLPort           = regexp.MustCompile("^" + URLPort + "$")
+	rxURLPath           = regexp.MustCompile("^" + URLPath + "$")
+	rxScheme            = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9+.-]*$`)
 )
@@ -0,0 +1,83 @@
+package validator
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// ProblemContentType is the media type of problem details documents.
+const ProblemContentType = "application/problem+json"
+
+// Problem is a problem details document (RFC 9457) describing validation
+// failures in its errors extension member.
+type Problem struct {
+	Type     string         `json:"type,omitempty"`
+	Title    string         `json:"title"`
+	Status   int            `json:"status"`
+	Detail   string         `json:"detail,omitempty"`
+	Instance string         `json:"instance,omitempty"`
+	Errors   []ProblemError `json:"errors"`
+}
+
+// ProblemError describes a failure in the errors member of a Problem.
+type ProblemError struct {
+	// Pointer is the JSON pointer to the failed field in the request body,
+	// as a URI fragment, e.g. #/items/3/address/zip.
+	Pointer string `json:"pointer"`
+	// Code is the name of the failed rule, e.g. required or email.
+	Code string `json:"code"`
+	// Message describes the failure.
+	Message string `json:"message"`
+}
+
+// NewProblem returns a problem details document for the failures err holds,
+// as returned by ValidateStruct, with the status 422 Unprocessable Content.
+// Fields are located with JSON pointers, and named in the messages, after
+// their json tag names.
+// It returns nil if err holds no Errors.
+func NewProblem(err error) *Problem {
+	var errs Errors
+	if !errors.As(err, &errs) {
+		return nil
+	}
+
+	problem := &Problem{
+		Title:  http.StatusText(http.StatusUnprocessableEntity),
+		Status: http.StatusUnprocessableEntity,
+		Errors: make([]ProblemError, len(errs)),
+	}
+	if len(errs) == 1 {
+		problem.Detail = "1 validation error."
+	} else {
+		problem.Detail = fmt.Sprintf("%d validation errors.", len(errs))
+	}
+	for i, e := range errs {
+		path := e.jsonPath
+		if path == "" {
+			path = e.Path
+		}
+		problem.Errors[i] = ProblemError{
+			Pointer: "#" + (&url.URL{Fragment: e.Pointer}).EscapedFragment(),
+			Code:    e.Rule,
+			Message: e.messageAt(path),
+		}
+	}
+
+	return problem
+}
+
+// WriteProblem writes problem to w as application/problem+json with its status.
+func WriteProblem(w http.ResponseWriter, problem *Problem) error {
+	body, err := json.Marshal(problem)
+	if err != nil {
+		return err
+	}
+
+	w.Header().Set("Content-Type", ProblemContentType)
+	w.WriteHeader(problem.Status)
+	_, err = w.Write(body)
+	return err
+}
@@ -0,0 +1,108 @@
+package validator
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+type testProblemAddress struct {
+	Zip string `json:"zip_code" valid:"required,numeric"`
+}
+
+type testProblemEmbedded struct {
+	Source string `json:"source" valid:"alpha"`
+}
+
+type testProblemRequest struct {
+	testProblemEmbedded
+	UserName string                        `json:"user_name,omitempty" valid:"required"`
+	Email    string                        `json:"-" valid:"email"`
+	Nickname string                        `json:"-," valid:"alpha"`
+	Age      int                           `valid:"min(18)"`
+	Items    []testProblemAddress          `json:"items"`
+	Extra    map[string]testProblemAddress `json:"extra"`
+}
+
+func TestNewProblem(t *testing.T) {
+	t.Parallel()
+
+	err := ValidateStruct(testProblemRequest{
+		testProblemEmbedded: testProblemEmbedded{Source: "1"},
+		Email:               "foo",
+		Nickname:            "1",
+		Age:                 17,
+		Items:               []testProblemAddress{{Zip: "12345"}, {Zip: "abc"}},
+		Extra:               map[string]testProblemAddress{"a/b~c d": {}},
+	})
+
+	problem := NewProblem(err)
+	if problem == nil {
+		t.Fatalf("Expected a problem for %v", err)
+	}
+	expected := &Problem{
+		Title:  "Unprocessable Entity",
+		Status: http.StatusUnprocessableEntity,
+		Detail: "7 validation errors.",
+		Errors: []ProblemError{
+			{"#/source", "alpha", "source does not validate as alpha"},
+			{"#/user_name", "required", "user_name is required"},
+			{"#/Email", "email", "Email does not validate as email"},
+			{"#/-", "alpha", "- does not validate as alpha"},
+			{"#/Age", "min", "Age does not validate as min(18)"},
+			{"#/items/1/zip_code", "numeric", "items[1].zip_code does not validate as numeric"},
+			{"#/extra/a~1b~0c%20d/zip_code", "required", "extra[a/b~c d].zip_code is required"},
+		},
+	}
+	if !reflect.DeepEqual(problem, expected) {
+		t.Errorf("Expected the problem to be %+v, got %+v", expected, problem)
+	}
+
+	if problem := NewProblem(ValidateStruct(testProblemAddress{Zip: "a"})); problem == nil || problem.Detail != "1 validation error." {
+		t.Errorf("Expected a problem with a single failure, got %+v", problem)
+	}
+	if problem := NewProblem(errors.New("other")); problem != nil {
+		t.Errorf("Expected no problem for other errors, got %+v", problem)
+	}
+	if problem := NewProblem(nil); problem != nil {
+		t.Errorf("Expected no problem for a nil error, got %+v", problem)
+	}
+}
+
+func TestWriteProblem(t *testing.T) {
+	t.Parallel()
+
+	rec := httptest.NewRecorder()
+	problem := NewProblem(ValidateStruct(testProblemAddress{}))
+	if err := WriteProblem(rec, problem); err != nil {
+		t.Fatalf("Expected WriteProblem to succeed, got %v", err)
+	}
+
+	if rec.Code != http.StatusUnprocessableEntity {
+		t.Errorf("Expected the status to be 422, got %d", rec.Code)
+	}
+	if contentType := rec.Header().Get("Content-Type"); contentType != ProblemContentType {
+		t.Errorf("Expected the content type to be %s, got %s", ProblemContentType, contentType)
+	}
+
+	var body map[string]interface{}
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+		t.Fatalf("Expected a JSON body, got %s", rec.Body)
+	}
+	expected := map[string]interface{}{
+		"title":  "Unprocessable Entity",
+		"status": float64(422),
+		"detail": "1 validation error.",
+		"errors": []interface{}{map[string]interface{}{
+			"pointer": "#/zip_code",
+			"code":    "required",
+			"message": "zip_code is required",
+		}},
+	}
+	if !reflect.DeepEqual(body, expected) {
+		t.Errorf("Expected the body to be %v, got %v", expected, body)
+	}
+}
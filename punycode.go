@@ -0,0 +1,246 @@
+package validator
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Bootstring parameters for Punycode, see RFC 3492 section 5.
+const (
+	punyBase        int32 = 36
+	punyTMin        int32 = 1
+	punyTMax        int32 = 26
+	punySkew        int32 = 38
+	punyDamp        int32 = 700
+	punyInitialBias int32 = 72
+	punyInitialN    int32 = 128
+)
+
+var (
+	errPunycodeOverflow = errors.New("validator: punycode overflow")
+	errPunycodeInvalid  = errors.New("validator: invalid punycode")
+)
+
+// toASCIIHost converts every non-ASCII label of host to its "xn--" Punycode
+// form. Labels are lowercased but otherwise not mapped, so callers should
+// pass hosts that are already in normalization form C.
+func toASCIIHost(host string) (string, error) {
+	labels := strings.Split(host, ".")
+	for i, label := range labels {
+		if isASCII(label) {
+			labels[i] = strings.ToLower(label)
+			continue
+		}
+
+		encoded, err := encodePunycode(strings.ToLower(label))
+		if err != nil {
+			return "", err
+		}
+		labels[i] = "xn--" + encoded
+	}
+
+	return strings.Join(labels, "."), nil
+}
+
+// toUnicodeHost converts every "xn--" label of host back to Unicode.
+func toUnicodeHost(host string) (string, error) {
+	labels := strings.Split(host, ".")
+	for i, label := range labels {
+		if len(label) < 4 || !strings.EqualFold(label[:4], "xn--") {
+			continue
+		}
+
+		decoded, err := decodePunycode(strings.ToLower(label[4:]))
+		if err != nil {
+			return "", err
+		}
+		labels[i] = decoded
+	}
+
+	return strings.Join(labels, "."), nil
+}
+
+// encodePunycode implements the encoding procedure of RFC 3492 section 6.3.
+func encodePunycode(s string) (string, error) {
+	runes := []rune(s)
+	out := make([]byte, 0, len(s))
+	for _, r := range runes {
+		if r < utf8.RuneSelf {
+			out = append(out, byte(r))
+		}
+	}
+
+	b := int32(len(out))
+	h := b
+	if b > 0 {
+		out = append(out, '-')
+	}
+
+	n, delta, bias := punyInitialN, int32(0), punyInitialBias
+	for h < int32(len(runes)) {
+		m := int32(math.MaxInt32)
+		for _, r := range runes {
+			if r >= n && r < m {
+				m = r
+			}
+		}
+		if (m - n) > (math.MaxInt32-delta)/(h+1) {
+			return "", errPunycodeOverflow
+		}
+		delta += (m - n) * (h + 1)
+		n = m
+
+		for _, r := range runes {
+			if r < n {
+				delta++
+				if delta == math.MaxInt32 {
+					return "", errPunycodeOverflow
+				}
+			}
+			if r != n {
+				continue
+			}
+
+			q := delta
+			for k := punyBase; ; k += punyBase {
+				t := k - bias
+				if t < punyTMin {
+					t = punyTMin
+				} else if t > punyTMax {
+					t = punyTMax
+				}
+				if q < t {
+					break
+				}
+				out = append(out, punyDigit(t+(q-t)%(punyBase-t)))
+				q = (q - t) / (punyBase - t)
+			}
+			out = append(out, punyDigit(q))
+
+			bias = punyAdapt(delta, h+1, h == b)
+			delta = 0
+			h++
+		}
+		delta++
+		n++
+	}
+
+	return string(out), nil
+}
+
+// decodePunycode implements the decoding procedure of RFC 3492 section 6.2.
+func decodePunycode(s string) (string, error) {
+	var out []rune
+	if b := strings.LastIndexByte(s, '-'); b >= 0 {
+		for i := 0; i < b; i++ {
+			if s[i] >= utf8.RuneSelf {
+				return "", errPunycodeInvalid
+			}
+			out = append(out, rune(s[i]))
+		}
+		s = s[b+1:]
+	}
+
+	n, i, bias := punyInitialN, int32(0), punyInitialBias
+	for pos := 0; pos < len(s); {
+		oldi, w := i, int32(1)
+		for k := punyBase; ; k += punyBase {
+			if pos == len(s) {
+				return "", errPunycodeInvalid
+			}
+			digit, ok := punyDecodeDigit(s[pos])
+			if !ok {
+				return "", errPunycodeInvalid
+			}
+			pos++
+
+			if digit > (math.MaxInt32-i)/w {
+				return "", errPunycodeOverflow
+			}
+			i += digit * w
+
+			t := k - bias
+			if t < punyTMin {
+				t = punyTMin
+			} else if t > punyTMax {
+				t = punyTMax
+			}
+			if digit < t {
+				break
+			}
+			if w > math.MaxInt32/(punyBase-t) {
+				return "", errPunycodeOverflow
+			}
+			w *= punyBase - t
+		}
+
+		length := int32(len(out) + 1)
+		bias = punyAdapt(i-oldi, length, oldi == 0)
+		if i/length > math.MaxInt32-n {
+			return "", errPunycodeOverflow
+		}
+		n += i / length
+		i %= length
+		if n > unicode.MaxRune || (0xD800 <= n && n <= 0xDFFF) {
+			return "", errPunycodeInvalid
+		}
+
+		out = append(out, 0)
+		copy(out[i+1:], out[i:])
+		out[i] = n
+		i++
+	}
+
+	return string(out), nil
+}
+
+func punyAdapt(delta, numPoints int32, first bool) int32 {
+	if first {
+		delta /= punyDamp
+	} else {
+		delta /= 2
+	}
+	delta += delta / numPoints
+
+	k := int32(0)
+	for delta > ((punyBase-punyTMin)*punyTMax)/2 {
+		delta /= punyBase - punyTMin
+		k += punyBase
+	}
+
+	return k + (punyBase-punyTMin+1)*delta/(delta+punySkew)
+}
+
+func punyDigit(d int32) byte {
+	if d < 26 {
+		return byte('a' + d)
+	}
+
+	return byte('0' + d - 26)
+}
+
+func punyDecodeDigit(c byte) (int32, bool) {
+	switch {
+	case 'a' <= c && c <= 'z':
+		return int32(c - 'a'), true
+	case 'A' <= c && c <= 'Z':
+		return int32(c - 'A'), true
+	case '0' <= c && c <= '9':
+		return int32(c-'0') + 26, true
+	default:
+		return 0, false
+	}
+}
+
+func isASCII(str string) bool {
+	for i := 0; i < len(str); i++ {
+		if str[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,35 @@
+package validator
+
+import "testing"
+
+func TestPunycodeHost(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		unicode string
+		ascii   string
+	}{
+		{"example.com", "example.com"},
+		{"bücher.example", "xn--bcher-kva.example"},
+		{"münchen.de", "xn--mnchen-3ya.de"},
+		{"foobar.中文网", "foobar.xn--fiq228c5hs"},
+		{"пример.рф", "xn--e1afmkfd.xn--p1ai"},
+		{"pаypal.com", "xn--pypal-4ve.com"},
+	}
+	for _, test := range tests {
+		ascii, err := toASCIIHost(test.unicode)
+		if err != nil || ascii != test.ascii {
+			t.Errorf("Expected toASCIIHost(%q) to be %q, got %q (%v)", test.unicode, test.ascii, ascii, err)
+		}
+		unicode, err := toUnicodeHost(test.ascii)
+		if err != nil || unicode != test.unicode {
+			t.Errorf("Expected toUnicodeHost(%q) to be %q, got %q (%v)", test.ascii, test.unicode, unicode, err)
+		}
+	}
+
+	for _, param := range []string{"xn--a-!", "xn--9999999999a", "xn--ü"} {
+		if _, err := toUnicodeHost(param); err == nil {
+			t.Errorf("Expected toUnicodeHost(%q) to fail", param)
+		}
+	}
+}
@@ -0,0 +1,129 @@
+package validator
+
+import (
+	"net/url"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// QueryParam describes one query string parameter of a QuerySchema.
+type QueryParam struct {
+	Name string
+	// Required rejects queries where the parameter is missing or only has
+	// empty values, as in "?id=".
+	Required bool
+	// Repeated allows the parameter to appear more than once.
+	Repeated bool
+	// Validator, if set, is called with every value of the parameter.
+	Validator func(str string) bool
+}
+
+// QuerySchema describes the query parameters accepted by an URL.
+type QuerySchema struct {
+	Params []QueryParam
+	// DisallowUnknown rejects parameters not listed in Params.
+	DisallowUnknown bool
+}
+
+// QueryRule identifies the check that rejected a query parameter.
+type QueryRule string
+
+// Rules reported in QueryError.Rule.
+const (
+	QueryRuleRequired QueryRule = "required"
+	QueryRuleRepeated QueryRule = "repeated"
+	QueryRuleInvalid  QueryRule = "invalid"
+	QueryRuleUnknown  QueryRule = "unknown"
+)
+
+var queryRuleMessages = map[QueryRule]string{
+	QueryRuleRequired: "is required",
+	QueryRuleRepeated: "must not be repeated",
+	QueryRuleInvalid:  "has an invalid value",
+	QueryRuleUnknown:  "is not allowed",
+}
+
+// QueryError describes a query parameter rejected by ValidateQuery.
+type QueryError struct {
+	Param string
+	Rule  QueryRule
+	// Value is the offending value for QueryRuleInvalid.
+	Value string
+}
+
+func (e *QueryError) Error() string {
+	msg := "validator: query parameter " + e.Param + " " + queryRuleMessages[e.Rule]
+	if e.Rule == QueryRuleInvalid {
+		msg += " " + url.QueryEscape(e.Value)
+	}
+
+	return msg
+}
+
+// QueryErrors is the list of errors returned by ValidateQuery.
+type QueryErrors []*QueryError
+
+func (es QueryErrors) Error() string {
+	msgs := make([]string, len(es))
+	for i, e := range es {
+		msgs[i] = e.Error()
+	}
+
+	return strings.Join(msgs, "; ")
+}
+
+// ValidateQuery checks the query string of u against schema. It returns the
+// error of url.ParseQuery if the query is malformed, QueryErrors with one
+// entry per failed check, or nil if the query is valid.
+func ValidateQuery(u *url.URL, schema QuerySchema) error {
+	values, err := url.ParseQuery(u.RawQuery)
+	if err != nil {
+		return err
+	}
+
+	var errs QueryErrors
+	known := make(map[string]bool, len(schema.Params))
+	for _, p := range schema.Params {
+		known[p.Name] = true
+
+		vs := values[p.Name]
+		if p.Required && !slices.ContainsFunc(vs, IsNotNull) {
+			errs = append(errs, &QueryError{Param: p.Name, Rule: QueryRuleRequired})
+			continue
+		}
+		if len(vs) == 0 {
+			continue
+		}
+		if len(vs) > 1 && !p.Repeated {
+			errs = append(errs, &QueryError{Param: p.Name, Rule: QueryRuleRepeated})
+		}
+		if p.Validator == nil {
+			continue
+		}
+		for _, v := range vs {
+			if !p.Validator(v) {
+				errs = append(errs, &QueryError{Param: p.Name, Rule: QueryRuleInvalid, Value: v})
+			}
+		}
+	}
+
+	if schema.DisallowUnknown {
+		var unknown []string
+		for name := range values {
+			if !known[name] {
+				unknown = append(unknown, name)
+			}
+		}
+		sort.Strings(unknown)
+		for _, name := range unknown {
+			errs = append(errs, &QueryError{Param: name, Rule: QueryRuleUnknown})
+		}
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+
+	return errs
+}
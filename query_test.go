@@ -0,0 +1,63 @@
+package validator
+
+import (
+	"errors"
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func TestValidateQuery(t *testing.T) {
+	t.Parallel()
+
+	schema := QuerySchema{
+		Params: []QueryParam{
+			{Name: "id", Required: true, Validator: IsNumeric},
+			{Name: "tag", Repeated: true, Validator: IsAlpha},
+			{Name: "page", Validator: IsNumeric},
+			{Name: "q"},
+		},
+		DisallowUnknown: true,
+	}
+
+	var tests = []struct {
+		param    string
+		expected QueryErrors
+	}{
+		{"/items?id=1", nil},
+		{"/items?id=1&tag=a&tag=b&page=2&q=x+y", nil},
+		{"/items", QueryErrors{{Param: "id", Rule: QueryRuleRequired}}},
+		{"/items?id=", QueryErrors{{Param: "id", Rule: QueryRuleRequired}}},
+		{"/items?id=&id=", QueryErrors{{Param: "id", Rule: QueryRuleRequired}}},
+		{"/items?id=1&page=&q=", nil},
+		{"/items?id=x", QueryErrors{{Param: "id", Rule: QueryRuleInvalid, Value: "x"}}},
+		{"/items?id=1&id=2", QueryErrors{{Param: "id", Rule: QueryRuleRepeated}}},
+		{"/items?id=1&tag=a&tag=b1", QueryErrors{{Param: "tag", Rule: QueryRuleInvalid, Value: "b1"}}},
+		{"/items?id=1&z=1&b=2", QueryErrors{{Param: "b", Rule: QueryRuleUnknown}, {Param: "z", Rule: QueryRuleUnknown}}},
+		{"/items?page=1&page=x", QueryErrors{
+			{Param: "id", Rule: QueryRuleRequired},
+			{Param: "page", Rule: QueryRuleRepeated},
+			{Param: "page", Rule: QueryRuleInvalid, Value: "x"},
+		}},
+	}
+	for _, test := range tests {
+		u, err := url.ParseRequestURI(test.param)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		err = ValidateQuery(u, schema)
+		var actual QueryErrors
+		if err != nil && !errors.As(err, &actual) {
+			t.Errorf("Expected ValidateQuery(%q) to return QueryErrors, got %v", test.param, err)
+			continue
+		}
+		if !reflect.DeepEqual(actual, test.expected) {
+			t.Errorf("Expected ValidateQuery(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+
+	if err := ValidateQuery(&url.URL{RawQuery: "a=%zz"}, QuerySchema{}); err == nil {
+		t.Error("Expected ValidateQuery to fail on a malformed query")
+	}
+}
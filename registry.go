@@ -0,0 +1,110 @@
+package validator
+
+import "sync"
+
+// Validator is a validator usable in struct tags, such as IsEmail.
+type Validator func(str string) bool
+
+// ParamValidator is a validator taking parameters from struct tags: the tag
+// rule `length(5|20)` calls the validator registered as length with the
+// params "5" and "20".
+type ParamValidator func(str string, params ...string) bool
+
+// tagRegistry maps names to validators and is safe for concurrent use.
+type tagRegistry[V any] struct {
+	mu         sync.RWMutex
+	validators map[string]V
+}
+
+func newTagRegistry[V any](validators map[string]V) *tagRegistry[V] {
+	return &tagRegistry[V]{validators: validators}
+}
+
+// Get returns the validator registered under name.
+func (r *tagRegistry[V]) Get(name string) (V, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	v, ok := r.validators[name]
+	return v, ok
+}
+
+// Set registers validator under name, replacing any previous validator.
+func (r *tagRegistry[V]) Set(name string, validator V) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.validators[name] = validator
+}
+
+// Delete removes the validator registered under name.
+func (r *tagRegistry[V]) Delete(name string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.validators, name)
+}
+
+// TagMap holds the validators usable by name in the valid tag, e.g.
+// `valid:"email"`. Applications may register their own. IsPublicURL resolves
+// host names, so it is not registered by default; applications accepting the
+// lookups may register it with TagMap.Set("publicurl", IsPublicURL).
+//
+// The tags of each struct field are parsed once, on the first validation of
+// its struct type, so validators must be registered before that validation
+// and replacing them afterwards does not affect types already validated.
+var TagMap = newTagRegistry(map[string]Validator{
+	"alpha":          IsAlpha,
+	"alphanum":       IsAlphanumeric,
+	"ascii":          IsASCII,
+	"email":          IsEmail,
+	"fullwidth":      IsFullWidth,
+	"halfwidth":      IsHalfWidth,
+	"multibyte":      IsMultibyte,
+	"numeric":        IsNumeric,
+	"printableascii": IsPrintableASCII,
+	"requri":         IsRequestURI,
+	"requrl":         IsRequestURL,
+	"uritemplate":    IsURITemplate,
+	"url":            IsURL,
+	"utfdigit":       IsUTFDigit,
+	"utfletter":      IsUTFLetter,
+	"utfletternum":   IsUTFLetterNumeric,
+	"utfnumeric":     IsUTFNumeric,
+	"variablewidth":  IsVariableWidth,
+})
+
+// ParamTagMap holds the validators usable with parameters in the valid tag,
+// e.g. `valid:"length(5|20)"`. Parameters are separated by "|".
+// Applications may register their own, see TagMap. The matches rule, whose
+// pattern is compiled once per struct field, is built in rather than
+// registered here.
+var ParamTagMap = newTagRegistry(map[string]ParamValidator{
+	"in": IsIn,
+	"length": func(str string, params ...string) bool {
+		return len(params) == 2 && ByteLength(str, params[0], params[1])
+	},
+	"range": func(str string, params ...string) bool {
+		if len(params) != 2 {
+			return false
+		}
+
+		value, err := ToFloat(str)
+		if err != nil {
+			return false
+		}
+		left, err := ToFloat(params[0])
+		if err != nil {
+			return false
+		}
+		right, err := ToFloat(params[1])
+		if err != nil {
+			return false
+		}
+
+		return InRange(value, left, right)
+	},
+	"runelength": func(str string, params ...string) bool {
+		return len(params) == 2 && RuneLength(str, params[0], params[1])
+	},
+})
@@ -0,0 +1,131 @@
+package validator
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestParamTagMap(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		rule     string
+		param    string
+		expected bool
+	}{
+		{"length(5|20)", "hello", true},
+		{"length(5|20)", "hi", false},
+		{"length(5)", "hello", false},
+		{"runelength(1|3)", "你好世", true},
+		{"runelength(1|3)", "你好世界", false},
+		{"range(0|100)", "42.5", true},
+		{"range(0|100)", "-1", false},
+		{"range(0|100)", "abc", false},
+		{"range(a|100)", "1", false},
+		{"in(a|b|c)", "b", true},
+		{"in(a|b|c)", "d", false},
+		{"matches(^[a-z]+$)", "abc", true},
+		{"matches(^[a-z]+$)", "abc1", false},
+		{"matches(^(cat|dog)$)", "dog", true},
+		{"matches(^(cat|dog)$)", "cow", false},
+	}
+	for _, test := range tests {
+		rule, err := parseTagValidator(test.rule)
+		if err != nil {
+			t.Errorf("Expected %q to parse, got %v", test.rule, err)
+			continue
+		}
+		actual := rule.validator(test.param)
+		if actual != test.expected {
+			t.Errorf("Expected %s on %q to be %v, got %v", test.rule, test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestTagRegistry(t *testing.T) {
+	t.Parallel()
+
+	TagMap.Set("test_even_length", func(str string) bool {
+		return len(str)%2 == 0
+	})
+	ParamTagMap.Set("test_prefix", func(str string, params ...string) bool {
+		for _, prefix := range params {
+			if strings.HasPrefix(str, prefix) {
+				return true
+			}
+		}
+		return false
+	})
+	defer TagMap.Delete("test_even_length")
+	defer ParamTagMap.Delete("test_prefix")
+
+	type request struct {
+		Code  string `valid:"required,test_even_length,test_prefix(AB|CD)"`
+		Name  string `valid:"runelength(1|5),matches(^[a-z]{1,3}$)"`
+		Level string `valid:"in(low|high)"`
+	}
+
+	if err := ValidateStruct(request{Code: "AB12", Name: "abc", Level: "low"}); err != nil {
+		t.Errorf("Expected the request to be valid, got %v", err)
+	}
+
+	actual := errorMessages(ValidateStruct(request{Code: "XY1", Name: "abcdef", Level: "mid"}))
+	expected := []string{
+		"validator: Code does not validate as test_even_length",
+		"validator: Code does not validate as test_prefix(AB|CD)",
+		"validator: Name does not validate as runelength(1|5)",
+		"validator: Name does not validate as matches(^[a-z]{1,3}$)",
+		"validator: Level does not validate as in(low|high)",
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("Expected ValidateStruct to fail with %q, got %q", expected, actual)
+	}
+
+	var tests = []struct {
+		param    interface{}
+		expected string
+	}{
+		{struct {
+			Name string `valid:"length(1|2"`
+		}{}, `malformed rule "length(1|2"`},
+		{struct {
+			Name string `valid:"unknown(1)"`
+		}{}, `unknown rule "unknown(1)"`},
+		{struct {
+			Name string `valid:"length"`
+		}{}, `unknown rule "length"`},
+		{struct {
+			Name string `valid:"matches(^[a-z$)"`
+		}{}, `invalid pattern in rule "matches(^[a-z$)"`},
+		{struct {
+			URL string `valid:"publicurl"`
+		}{}, `unknown rule "publicurl"`},
+	}
+	for _, test := range tests {
+		err := ValidateStruct(test.param)
+		if err == nil || !strings.Contains(err.Error(), test.expected) {
+			t.Errorf("Expected ValidateStruct(%#v) to fail with %q, got %v", test.param, test.expected, err)
+		}
+	}
+}
+
+func TestTagRegistryConcurrency(t *testing.T) {
+	t.Parallel()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			TagMap.Set("test_concurrent", IsAlpha)
+		}()
+		go func() {
+			defer wg.Done()
+			TagMap.Get("email")
+		}()
+	}
+	wg.Wait()
+	TagMap.Delete("test_concurrent")
+}
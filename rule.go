@@ -0,0 +1,81 @@
+package validator
+
+import "strings"
+
+// Rule is a Validator composed with And, Or, Not, When, Each and Optional.
+// Any Is* function of the shape func(str string) bool is a Rule:
+//
+//	// A comma-separated list of emails, or nothing.
+//	recipients := validator.Optional(validator.Each(",", validator.Strict(validator.IsEmail)))
+type Rule = Validator
+
+// And returns a rule checking that the string satisfies all rules.
+// With no rules, every string is valid.
+func And(rules ...Rule) Rule {
+	return func(str string) bool {
+		for _, rule := range rules {
+			if !rule(str) {
+				return false
+			}
+		}
+
+		return true
+	}
+}
+
+// Or returns a rule checking that the string satisfies at least one of rules.
+// With no rules, no string is valid.
+func Or(rules ...Rule) Rule {
+	return func(str string) bool {
+		for _, rule := range rules {
+			if rule(str) {
+				return true
+			}
+		}
+
+		return false
+	}
+}
+
+// Not returns a rule checking that the string does not satisfy rule.
+func Not(rule Rule) Rule {
+	return func(str string) bool {
+		return !rule(str)
+	}
+}
+
+// When returns a rule checking the string against rule only if it satisfies
+// cond; other strings are valid.
+func When(cond, rule Rule) Rule {
+	return func(str string) bool {
+		return !cond(str) || rule(str)
+	}
+}
+
+// Each returns a rule splitting the string at sep and checking that every
+// element, including empty ones, satisfies rule.
+func Each(sep string, rule Rule) Rule {
+	return func(str string) bool {
+		for _, elem := range strings.Split(str, sep) {
+			if !rule(elem) {
+				return false
+			}
+		}
+
+		return true
+	}
+}
+
+// Optional returns a rule accepting the empty string, regardless of
+// SetEmptyStringValid, and checking other strings against rule.
+func Optional(rule Rule) Rule {
+	return func(str string) bool {
+		return IsNull(str) || rule(str)
+	}
+}
+
+// RegisterRule registers rule in TagMap under name, so that it can be used in
+// the valid tag of struct fields.
+func RegisterRule(name string, rule Rule) {
+	TagMap.Set(name, rule)
+}
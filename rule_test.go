@@ -0,0 +1,70 @@
+package validator
+
+import "testing"
+
+func TestRuleCombinators(t *testing.T) {
+	t.Parallel()
+
+	short := Rule(func(str string) bool { return RuneLength(str, "0", "3") })
+	hasPort := Rule(func(str string) bool { return Matches(str, `:\d+$`) })
+	wellKnownPort := Rule(func(str string) bool { return Matches(str, `:\d{1,3}$`) })
+	hostPort := And(Strict(IsPrintableASCII), IsURL, When(hasPort, wellKnownPort))
+	recipients := Optional(Each(",", Strict(IsEmail)))
+
+	var tests = []struct {
+		name     string
+		rule     Rule
+		param    string
+		expected bool
+	}{
+		{"And()", And(), "anything", true},
+		{"And", And(IsAlpha, short), "abc", true},
+		{"And", And(IsAlpha, short), "abcd", false},
+		{"And", And(IsAlpha, short), "ab1", false},
+		{"Or()", Or(), "anything", false},
+		{"Or", Or(IsAlpha, IsNumeric), "123", true},
+		{"Or", Or(IsAlpha, IsNumeric), "abc", true},
+		{"Or", Or(IsAlpha, IsNumeric), "a1", false},
+		{"Not", Not(IsNumeric), "abc", true},
+		{"Not", Not(IsNumeric), "123", false},
+		{"When", When(IsNumeric, short), "abcdef", true},
+		{"When", When(IsNumeric, short), "123", true},
+		{"When", When(IsNumeric, short), "1234", false},
+		{"Each", Each(",", Strict(IsAlpha)), "a,b,c", true},
+		{"Each", Each(",", Strict(IsAlpha)), "a,,c", false},
+		{"Each", Each(",", Strict(IsAlpha)), "a,1", false},
+		{"Each", Each(" | ", IsNumeric), "1 | 2", true},
+		{"Optional", Optional(Strict(IsAlpha)), "", true},
+		{"Optional", Optional(Strict(IsAlpha)), "abc", true},
+		{"Optional", Optional(Strict(IsAlpha)), "a1", false},
+		{"hostPort", hostPort, "example.com:80", true},
+		{"hostPort", hostPort, "example.com", true},
+		{"hostPort", hostPort, "example.com:8080", false},
+		{"hostPort", hostPort, "", false},
+		{"recipients", recipients, "", true},
+		{"recipients", recipients, "foo@bar.com,baz@qux.com", true},
+		{"recipients", recipients, "foo@bar.com,", false},
+	}
+	for _, test := range tests {
+		actual := test.rule(test.param)
+		if actual != test.expected {
+			t.Errorf("Expected %s(%q) to be %v, got %v", test.name, test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestRegisterRule(t *testing.T) {
+	RegisterRule("test_recipients", Each(",", Strict(IsEmail)))
+	defer TagMap.Delete("test_recipients")
+
+	type message struct {
+		To string `valid:"required,test_recipients"`
+	}
+
+	if err := ValidateStruct(message{To: "foo@bar.com,baz@qux.com"}); err != nil {
+		t.Errorf("Expected the message to be valid, got %v", err)
+	}
+	if err := ValidateStruct(message{To: "foo@bar.com,baz"}); err == nil {
+		t.Errorf("Expected the message to be invalid")
+	}
+}
@@ -0,0 +1,135 @@
+package validator
+
+import (
+	"html"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// charSet returns a predicate matching the characters of chars, which lists
+// characters and ranges such as "a-z0-9_". A '-' at the start or end of chars
+// is taken literally.
+func charSet(chars string) func(c rune) bool {
+	runes := []rune(chars)
+	return func(c rune) bool {
+		for i := 0; i < len(runes); i++ {
+			if i+2 < len(runes) && runes[i+1] == '-' {
+				if runes[i] <= c && c <= runes[i+2] {
+					return true
+				}
+				i += 2
+				continue
+			}
+			if runes[i] == c {
+				return true
+			}
+		}
+
+		return false
+	}
+}
+
+// Trim removes the characters of chars from both sides of the string, see
+// WhiteList for the syntax of chars. If chars is empty, it removes whitespace.
+func Trim(str, chars string) string {
+	return LeftTrim(RightTrim(str, chars), chars)
+}
+
+// LeftTrim removes the characters of chars from the left side of the string.
+// If chars is empty, it removes whitespace.
+func LeftTrim(str, chars string) string {
+	if chars == "" {
+		return strings.TrimLeftFunc(str, unicode.IsSpace)
+	}
+
+	return strings.TrimLeftFunc(str, charSet(chars))
+}
+
+// RightTrim removes the characters of chars from the right side of the string.
+// If chars is empty, it removes whitespace.
+func RightTrim(str, chars string) string {
+	if chars == "" {
+		return strings.TrimRightFunc(str, unicode.IsSpace)
+	}
+
+	return strings.TrimRightFunc(str, charSet(chars))
+}
+
+// WhiteList removes the characters of the string that are not in chars.
+// chars lists characters and ranges, e.g. "a-z0-9_"; a '-' at its start or
+// end is taken literally.
+func WhiteList(str, chars string) string {
+	keep := charSet(chars)
+	return strings.Map(func(c rune) rune {
+		if keep(c) {
+			return c
+		}
+		return -1
+	}, str)
+}
+
+// BlackList removes the characters of the string that are in chars, see
+// WhiteList for the syntax of chars.
+func BlackList(str, chars string) string {
+	drop := charSet(chars)
+	return strings.Map(func(c rune) rune {
+		if drop(c) {
+			return -1
+		}
+		return c
+	}, str)
+}
+
+// StripLow removes the ASCII control characters (0x00-0x1F and 0x7F) of the
+// string. If keepNewLines is true, "\n" and "\r" are kept.
+func StripLow(str string, keepNewLines bool) string {
+	return strings.Map(func(c rune) rune {
+		if keepNewLines && (c == '\n' || c == '\r') {
+			return c
+		}
+		if c < 0x20 || c == 0x7F {
+			return -1
+		}
+		return c
+	}, str)
+}
+
+// ReplacePattern replaces the matches of the regular expression pattern in
+// the string with replace, which may refer to submatches as in
+// regexp.Regexp.ReplaceAllString. It returns an error if pattern does not compile.
+func ReplacePattern(str, pattern, replace string) (string, error) {
+	r, err := regexp.Compile(pattern)
+	if err != nil {
+		return "", err
+	}
+
+	return r.ReplaceAllString(str, replace), nil
+}
+
+// Escape replaces the characters <, >, &, ' and " of the string with HTML entities.
+func Escape(str string) string {
+	return html.EscapeString(str)
+}
+
+// NormalizeWhitespace collapses every run of unicode whitespace in the string
+// into a single space and removes leading and trailing whitespace.
+func NormalizeWhitespace(str string) string {
+	var b strings.Builder
+	b.Grow(len(str))
+
+	space := false
+	for _, c := range str {
+		if unicode.IsSpace(c) {
+			space = true
+			continue
+		}
+		if space && b.Len() > 0 {
+			b.WriteByte(' ')
+		}
+		space = false
+		b.WriteRune(c)
+	}
+
+	return b.String()
+}
@@ -0,0 +1,203 @@
+package validator
+
+import "testing"
+
+func TestTrim(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		chars    string
+		expected string
+	}{
+		{"  \r\n\ttext\r\n\t  ", "", "text"},
+		{"　text ", "", "text"},
+		{"xx  text  xx", "x", "  text  "},
+		{"--==text==--", "-=", "text"},
+		{"1234text5678", "0-9", "text"},
+		{"", "", ""},
+	}
+	for _, test := range tests {
+		actual := Trim(test.param, test.chars)
+		if actual != test.expected {
+			t.Errorf("Expected Trim(%q, %q) to be %q, got %q", test.param, test.chars, test.expected, actual)
+		}
+	}
+}
+
+func TestLeftTrim(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		chars    string
+		expected string
+	}{
+		{"  \r\n\ttext\r\n\t  ", "", "text\r\n\t  "},
+		{"010100201000", "01", "201000"},
+		{"a-z", "a-", "z"},
+	}
+	for _, test := range tests {
+		actual := LeftTrim(test.param, test.chars)
+		if actual != test.expected {
+			t.Errorf("Expected LeftTrim(%q, %q) to be %q, got %q", test.param, test.chars, test.expected, actual)
+		}
+	}
+}
+
+func TestRightTrim(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		chars    string
+		expected string
+	}{
+		{"  \r\n\ttext\r\n\t  ", "", "  \r\n\ttext"},
+		{"010100201000", "01", "0101002"},
+		{"abc--", "-", "abc"},
+	}
+	for _, test := range tests {
+		actual := RightTrim(test.param, test.chars)
+		if actual != test.expected {
+			t.Errorf("Expected RightTrim(%q, %q) to be %q, got %q", test.param, test.chars, test.expected, actual)
+		}
+	}
+}
+
+func TestWhiteList(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		chars    string
+		expected string
+	}{
+		{"abcdef", "abc", "abc"},
+		{"aaaaaaaaaabbbbbbbbbb", "abc", "aaaaaaaaaabbbbbbbbbb"},
+		{"a1b2c3", "abc", "abc"},
+		{"a1b2c3", "0-9", "123"},
+		{"my-user_name!", "a-z_-", "my-user_name"},
+		{"Straße", "a-zß", "traße"},
+		{"", "abc", ""},
+	}
+	for _, test := range tests {
+		actual := WhiteList(test.param, test.chars)
+		if actual != test.expected {
+			t.Errorf("Expected WhiteList(%q, %q) to be %q, got %q", test.param, test.chars, test.expected, actual)
+		}
+	}
+}
+
+func TestBlackList(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		chars    string
+		expected string
+	}{
+		{"abcdef", "abc", "def"},
+		{"aaaaaaaaaabbbbbbbbbb", "abc", ""},
+		{"a1b2c3", "abc", "123"},
+		{"a1b2c3", "0-9", "abc"},
+		{"", "abc", ""},
+	}
+	for _, test := range tests {
+		actual := BlackList(test.param, test.chars)
+		if actual != test.expected {
+			t.Errorf("Expected BlackList(%q, %q) to be %q, got %q", test.param, test.chars, test.expected, actual)
+		}
+	}
+}
+
+func TestStripLow(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param        string
+		keepNewLines bool
+		expected     string
+	}{
+		{"foo\x00", false, "foo"},
+		{"\x7Ffoo\x02", false, "foo"},
+		{"\x01\x09", false, ""},
+		{"foo\x0A\x0D", false, "foo"},
+		{"perché", false, "perché"},
+		{"€", false, "€"},
+		{"∆\x0A", false, "∆"},
+		{"foo\x0A\x0D", true, "foo\x0A\x0D"},
+		{"\x03foo\x0A\x0D", true, "foo\x0A\x0D"},
+	}
+	for _, test := range tests {
+		actual := StripLow(test.param, test.keepNewLines)
+		if actual != test.expected {
+			t.Errorf("Expected StripLow(%q, %v) to be %q, got %q", test.param, test.keepNewLines, test.expected, actual)
+		}
+	}
+}
+
+func TestReplacePattern(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		pattern  string
+		replace  string
+		expected string
+	}{
+		{"ab123ba", "[0-9]+", "?", "ab?ba"},
+		{"abacaba", "[c-z]+", "", "abaaba"},
+		{"John Smith", `(\w+) (\w+)`, "$2, $1", "Smith, John"},
+	}
+	for _, test := range tests {
+		actual, err := ReplacePattern(test.param, test.pattern, test.replace)
+		if err != nil || actual != test.expected {
+			t.Errorf("Expected ReplacePattern(%q, %q, %q) to be %q, got %q, %v", test.param, test.pattern, test.replace, test.expected, actual, err)
+		}
+	}
+
+	if _, err := ReplacePattern("abc", "[a-", ""); err == nil {
+		t.Errorf("Expected ReplacePattern with an invalid pattern to fail")
+	}
+}
+
+func TestEscape(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected string
+	}{
+		{`<img alt="foo&bar">`, "&lt;img alt=&#34;foo&amp;bar&#34;&gt;"},
+		{"it's", "it&#39;s"},
+		{"plain", "plain"},
+	}
+	for _, test := range tests {
+		actual := Escape(test.param)
+		if actual != test.expected {
+			t.Errorf("Expected Escape(%q) to be %q, got %q", test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestNormalizeWhitespace(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected string
+	}{
+		{"", ""},
+		{"   ", ""},
+		{"a  b", "a b"},
+		{" \t a \r\n b  　c\n", "a b c"},
+		{"single", "single"},
+	}
+	for _, test := range tests {
+		actual := NormalizeWhitespace(test.param)
+		if actual != test.expected {
+			t.Errorf("Expected NormalizeWhitespace(%q) to be %q, got %q", test.param, test.expected, actual)
+		}
+	}
+}
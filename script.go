@@ -0,0 +1,83 @@
+package validator
+
+import "unicode"
+
+// ScriptOptions relaxes IsScriptWith for characters that belong to the
+// Common script but are routinely written inside names.
+type ScriptOptions struct {
+	// AllowSpaces accepts space separators, including the ideographic space U+3000.
+	AllowSpaces bool
+	// AllowMiddleDots accepts U+00B7, the katakana middle dot U+30FB and its
+	// halfwidth form U+FF65.
+	AllowMiddleDots bool
+	// AllowProlongedSoundMarks accepts the prolonged sound mark U+30FC and its
+	// halfwidth form U+FF70.
+	AllowProlongedSoundMarks bool
+}
+
+// IsScript checks if the string contains only characters of the given scripts,
+// such as unicode.Han or unicode.Katakana. Combining marks of the Inherited
+// script and kana voiced sound marks are accepted after such a character.
+// Empty string is valid.
+func IsScript(str string, scripts ...*unicode.RangeTable) bool {
+	return IsScriptWith(str, ScriptOptions{}, scripts...)
+}
+
+// IsScriptWith checks if the string contains only characters of the given
+// scripts and the separators allowed by opts, with at least one character of
+// the scripts. Empty string is valid.
+func IsScriptWith(str string, opts ScriptOptions, scripts ...*unicode.RangeTable) bool {
+	if IsNull(str) {
+		return emptyStringValid()
+	}
+
+	found, prev := false, false
+	for _, c := range str {
+		switch {
+		case unicode.IsOneOf(scripts, c):
+			found, prev = true, true
+		case prev && (unicode.Is(unicode.Inherited, c) || isVoicedSoundMark(c)):
+		case opts.AllowSpaces && unicode.Is(unicode.Zs, c):
+			prev = false
+		case opts.AllowMiddleDots && (c == '·' || c == '・' || c == '･'):
+			prev = false
+		case opts.AllowProlongedSoundMarks && (c == 'ー' || c == 'ｰ'):
+			prev = false
+		default:
+			return false
+		}
+	}
+
+	return found
+}
+
+// isVoicedSoundMark reports whether c is a spacing or halfwidth kana
+// (semi-)voiced sound mark, which belong to the Common script.
+func isVoicedSoundMark(c rune) bool {
+	return c == '゛' || c == '゜' || c == 'ﾞ' || c == 'ﾟ'
+}
+
+// IsHan checks if the string contains only Han (Chinese) characters. Empty string is valid.
+func IsHan(str string) bool {
+	return IsScript(str, unicode.Han)
+}
+
+// IsHiragana checks if the string contains only Hiragana characters. Empty string is valid.
+func IsHiragana(str string) bool {
+	return IsScript(str, unicode.Hiragana)
+}
+
+// IsKatakana checks if the string contains only Katakana characters. Empty string is valid.
+func IsKatakana(str string) bool {
+	return IsScript(str, unicode.Katakana)
+}
+
+// IsHangul checks if the string contains only Hangul characters. Empty string is valid.
+func IsHangul(str string) bool {
+	return IsScript(str, unicode.Hangul)
+}
+
+// IsCyrillic checks if the string contains only Cyrillic characters. Empty string is valid.
+func IsCyrillic(str string) bool {
+	return IsScript(str, unicode.Cyrillic)
+}
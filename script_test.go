@@ -0,0 +1,187 @@
+package validator
+
+import (
+	"testing"
+	"unicode"
+)
+
+func TestIsScript(t *testing.T) {
+	t.Parallel()
+
+	japanese := []*unicode.RangeTable{unicode.Han, unicode.Hiragana, unicode.Katakana}
+
+	var tests = []struct {
+		param    string
+		scripts  []*unicode.RangeTable
+		expected bool
+	}{
+		{"abc", japanese, false},
+		{"山田 太郎", japanese, false},
+		{"山田1", japanese, false},
+		{"한국", japanese, false},
+		{"abc", nil, false},
+
+		{"", japanese, true},
+		{"", nil, true},
+		{"山田たろう", japanese, true},
+		{"佐々木カタカナ", japanese, true},
+		{"が", japanese, true},
+		{"abc", []*unicode.RangeTable{unicode.Latin}, true},
+		{"Ελλάδα", []*unicode.RangeTable{unicode.Greek}, true},
+	}
+	for _, test := range tests {
+		actual := IsScript(test.param, test.scripts...)
+		if actual != test.expected {
+			t.Errorf("Expected IsScript(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestIsScriptWith(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		opts     ScriptOptions
+		expected bool
+	}{
+		{"ヤマダ タロウ", ScriptOptions{}, false},
+		{"スーパー", ScriptOptions{}, false},
+		{"ジョン・スミス", ScriptOptions{}, false},
+		{"ヤマダ　タロウ", ScriptOptions{AllowMiddleDots: true}, false},
+		{" ", ScriptOptions{AllowSpaces: true}, false},
+		{"゙カ", ScriptOptions{}, false},
+
+		{"ヤマダ タロウ", ScriptOptions{AllowSpaces: true}, true},
+		{"ヤマダ　タロウ", ScriptOptions{AllowSpaces: true}, true},
+		{"スーパー", ScriptOptions{AllowProlongedSoundMarks: true}, true},
+		{"ｽｰﾊﾟｰ", ScriptOptions{AllowProlongedSoundMarks: true}, true},
+		{"ジョン・スミス", ScriptOptions{AllowMiddleDots: true}, true},
+		{"ｼﾞｮﾝ･ｽﾐｽ", ScriptOptions{AllowMiddleDots: true}, true},
+	}
+	for _, test := range tests {
+		actual := IsScriptWith(test.param, test.opts, unicode.Katakana)
+		if actual != test.expected {
+			t.Errorf("Expected IsScriptWith(%q, %+v, Katakana) to be %v, got %v", test.param, test.opts, test.expected, actual)
+		}
+	}
+}
+
+func TestIsHan(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"abc", false},
+		{"王 伟", false},
+		{"ひらがな", false},
+		{"王1", false},
+
+		{"", true},
+		{"王伟", true},
+		{"張偉", true},
+		{"々", true},
+	}
+	for _, test := range tests {
+		actual := IsHan(test.param)
+		if actual != test.expected {
+			t.Errorf("Expected IsHan(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestIsHiragana(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"カタカナ", false},
+		{"漢字", false},
+		{"abc", false},
+
+		{"", true},
+		{"ひらがな", true},
+		{"やまだたろう", true},
+	}
+	for _, test := range tests {
+		actual := IsHiragana(test.param)
+		if actual != test.expected {
+			t.Errorf("Expected IsHiragana(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestIsKatakana(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"ひらがな", false},
+		{"漢字", false},
+		{"abc", false},
+		{"スーパー", false},
+
+		{"", true},
+		{"カタカナ", true},
+		{"ｶﾀｶﾅ", true},
+		{"ヤマダタロウ", true},
+	}
+	for _, test := range tests {
+		actual := IsKatakana(test.param)
+		if actual != test.expected {
+			t.Errorf("Expected IsKatakana(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestIsHangul(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"소aBC", false},
+		{"漢字", false},
+		{"김 민준", false},
+
+		{"", true},
+		{"소주", true},
+		{"김민준", true},
+	}
+	for _, test := range tests {
+		actual := IsHangul(test.param)
+		if actual != test.expected {
+			t.Errorf("Expected IsHangul(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestIsCyrillic(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"pаypal", false},
+		{"Ivan", false},
+		{"Иван Петров", false},
+
+		{"", true},
+		{"Иван", true},
+		{"Київ", true},
+	}
+	for _, test := range tests {
+		actual := IsCyrillic(test.param)
+		if actual != test.expected {
+			t.Errorf("Expected IsCyrillic(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+}
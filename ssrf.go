@@ -0,0 +1,270 @@
+package validator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"net/url"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// ErrNonPublicAddress is returned by SafeURLChecker when a URL or connection
+// targets an address that is not publicly routable.
+var ErrNonPublicAddress = errors.New("validator: address is not public")
+
+// ErrUnsafeURL is returned by SafeURLChecker when a URL cannot be checked,
+// e.g. because it has no host or uses a scheme that is not allowed.
+var ErrUnsafeURL = errors.New("validator: url is not allowed for outbound requests")
+
+// IPResolver resolves host names to IP addresses. *net.Resolver implements it.
+type IPResolver interface {
+	LookupIPAddr(ctx context.Context, host string) ([]net.IPAddr, error)
+}
+
+// SafeURLChecker decides whether a user supplied URL may be fetched by the
+// server without exposing internal services (SSRF). The zero value accepts
+// http and https URLs and resolves names with net.DefaultResolver.
+//
+// Resolving the host before the request is not enough on its own, since the
+// name may resolve differently at connect time (DNS rebinding). Install
+// Control on the net.Dialer used for the request to apply the same policy to
+// every address actually dialed:
+//
+//	dialer := &net.Dialer{Control: checker.Control}
+type SafeURLChecker struct {
+	// Resolver is used to resolve host names. Nil means net.DefaultResolver.
+	Resolver IPResolver
+
+	// Schemes lists the accepted URL schemes. Nil means http and https.
+	Schemes []string
+}
+
+var defaultSafeURLChecker = &SafeURLChecker{}
+
+// publicURLTimeout bounds the name resolution of IsPublicURL.
+const publicURLTimeout = 5 * time.Second
+
+// IsPublicURL checks if the string is an URL whose host only resolves to
+// publicly routable addresses, rejecting loopback, private, link-local,
+// multicast and reserved ranges. Names are resolved with net.DefaultResolver
+// and fail after 5 seconds, see IsPublicURLWith for other resolvers and
+// deadlines.
+func IsPublicURL(str string) bool {
+	ctx, cancel := context.WithTimeout(context.Background(), publicURLTimeout)
+	defer cancel()
+
+	return IsPublicURLWith(ctx, str, nil)
+}
+
+// IsPublicURLWith checks as IsPublicURL if the string is an URL whose host
+// only resolves to public addresses, applying the policy of checker, or of
+// the zero SafeURLChecker if it is nil, and resolving names within ctx.
+func IsPublicURLWith(ctx context.Context, str string, checker *SafeURLChecker) bool {
+	if checker == nil {
+		checker = defaultSafeURLChecker
+	}
+
+	return IsURL(str) && checker.Check(ctx, str) == nil
+}
+
+// IsPublicIP checks if the string is an IP address that is publicly routable.
+// Numeric forms accepted by inet_aton, such as "2130706433" or "0177.0.0.1",
+// are normalized before the check.
+func IsPublicIP(str string) bool {
+	addr, ok := parseHostIP(str)
+	return ok && isPublicAddr(addr)
+}
+
+// Check returns nil if rawurl may be fetched, or an error wrapping
+// ErrUnsafeURL or ErrNonPublicAddress otherwise.
+func (c *SafeURLChecker) Check(ctx context.Context, rawurl string) error {
+	u, err := url.Parse(rawurl)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrUnsafeURL, err)
+	}
+
+	schemes := c.Schemes
+	if schemes == nil {
+		schemes = []string{"http", "https"}
+	}
+	if !containsFold(schemes, u.Scheme) {
+		return fmt.Errorf("%w: scheme %q", ErrUnsafeURL, u.Scheme)
+	}
+
+	host := normalizeHost(u.Hostname())
+	if host == "" {
+		return fmt.Errorf("%w: missing host", ErrUnsafeURL)
+	}
+	if addr, ok := parseHostIP(host); ok {
+		return checkPublicAddr(host, addr)
+	}
+
+	resolver := c.Resolver
+	if resolver == nil {
+		resolver = net.DefaultResolver
+	}
+	addrs, err := resolver.LookupIPAddr(ctx, host)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrUnsafeURL, err)
+	}
+	if len(addrs) == 0 {
+		return fmt.Errorf("%w: %s has no addresses", ErrUnsafeURL, host)
+	}
+	for _, ia := range addrs {
+		addr, ok := netip.AddrFromSlice(ia.IP)
+		if !ok {
+			return fmt.Errorf("%w: %s resolves to %v", ErrNonPublicAddress, host, ia.IP)
+		}
+		if err := checkPublicAddr(host, addr); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Control can be used as net.Dialer.Control to refuse connections to
+// non-public addresses at connect time.
+func (c *SafeURLChecker) Control(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrUnsafeURL, err)
+	}
+
+	addr, ok := parseHostIP(host)
+	if !ok {
+		return fmt.Errorf("%w: %s is not an IP address", ErrNonPublicAddress, host)
+	}
+
+	return checkPublicAddr(host, addr)
+}
+
+func checkPublicAddr(host string, addr netip.Addr) error {
+	if !isPublicAddr(addr) {
+		return fmt.Errorf("%w: %s resolves to %s", ErrNonPublicAddress, host, addr)
+	}
+
+	return nil
+}
+
+// nonPublicPrefixes lists special-purpose ranges (RFC 6890 and successors)
+// not already covered by the netip.Addr predicates used in isPublicAddr.
+var nonPublicPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+	netip.MustParsePrefix("192.0.0.0/24"),
+	netip.MustParsePrefix("192.0.2.0/24"),
+	netip.MustParsePrefix("198.18.0.0/15"),
+	netip.MustParsePrefix("198.51.100.0/24"),
+	netip.MustParsePrefix("203.0.113.0/24"),
+	netip.MustParsePrefix("240.0.0.0/4"),
+	netip.MustParsePrefix("::/96"), // IPv4-compatible, deprecated by RFC 4291
+	netip.MustParsePrefix("100::/64"),
+	netip.MustParsePrefix("2001::/23"),
+	netip.MustParsePrefix("2001:db8::/32"),
+	netip.MustParsePrefix("fec0::/10"),
+}
+
+// embeddedIPv4Prefixes lists IPv6 ranges that carry an IPv4 address which
+// must be checked as well.
+var embeddedIPv4Prefixes = []netip.Prefix{
+	netip.MustParsePrefix("64:ff9b::/96"),
+	netip.MustParsePrefix("64:ff9b:1::/48"), // local-use NAT64 (RFC 8215), IPv4 in the last 32 bits
+	netip.MustParsePrefix("2002::/16"),
+}
+
+func isPublicAddr(addr netip.Addr) bool {
+	addr = addr.Unmap().WithZone("")
+	if !addr.IsValid() || addr.IsUnspecified() || addr.IsLoopback() || addr.IsPrivate() ||
+		addr.IsLinkLocalUnicast() || addr.IsLinkLocalMulticast() || addr.IsInterfaceLocalMulticast() ||
+		addr.IsMulticast() {
+		return false
+	}
+	for _, p := range nonPublicPrefixes {
+		if p.Contains(addr) {
+			return false
+		}
+	}
+
+	for _, p := range embeddedIPv4Prefixes {
+		if !p.Contains(addr) {
+			continue
+		}
+		b := addr.As16()
+		v4 := netip.AddrFrom4([4]byte{b[12], b[13], b[14], b[15]})
+		if p.Bits() == 16 {
+			v4 = netip.AddrFrom4([4]byte{b[2], b[3], b[4], b[5]})
+		}
+		return isPublicAddr(v4)
+	}
+
+	return true
+}
+
+// normalizeHost lowercases host and strips a trailing dot and IPv6 brackets.
+func normalizeHost(host string) string {
+	host = strings.ToLower(strings.TrimSuffix(host, "."))
+	return strings.TrimSuffix(strings.TrimPrefix(host, "["), "]")
+}
+
+// parseHostIP parses host as an IP address, also accepting the legacy
+// inet_aton forms (decimal, octal and hexadecimal parts, fewer than four parts)
+// that many HTTP clients still resolve.
+func parseHostIP(host string) (netip.Addr, bool) {
+	host = normalizeHost(host)
+	if addr, err := netip.ParseAddr(host); err == nil {
+		return addr, true
+	}
+
+	parts := strings.Split(host, ".")
+	if len(parts) > 4 {
+		return netip.Addr{}, false
+	}
+
+	var ip uint32
+	for i, part := range parts {
+		n, ok := parseInetPart(part)
+		if !ok {
+			return netip.Addr{}, false
+		}
+
+		if i < len(parts)-1 {
+			if n > 0xff {
+				return netip.Addr{}, false
+			}
+			ip |= uint32(n) << (8 * (3 - i))
+			continue
+		}
+
+		remaining := 8 * (4 - i)
+		if remaining < 32 && n >= 1<<remaining {
+			return netip.Addr{}, false
+		}
+		ip |= uint32(n)
+	}
+
+	return netip.AddrFrom4([4]byte{byte(ip >> 24), byte(ip >> 16), byte(ip >> 8), byte(ip)}), true
+}
+
+// parseInetPart parses one part of an inet_aton address: hexadecimal with a
+// 0x prefix, octal with a leading 0, decimal otherwise.
+func parseInetPart(part string) (uint64, bool) {
+	base := 10
+	switch {
+	case strings.HasPrefix(part, "0x"):
+		part, base = part[2:], 16
+	case len(part) > 1 && part[0] == '0':
+		part, base = part[1:], 8
+	}
+	if part == "" || strings.ContainsAny(part, "+-_") {
+		return 0, false
+	}
+
+	n, err := strconv.ParseUint(part, base, 32)
+	return n, err == nil
+}
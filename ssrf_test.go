@@ -0,0 +1,199 @@
+package validator
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+)
+
+type stubResolver map[string][]string
+
+func (r stubResolver) LookupIPAddr(_ context.Context, host string) ([]net.IPAddr, error) {
+	ips, ok := r[host]
+	if !ok {
+		return nil, errors.New("no such host")
+	}
+
+	addrs := make([]net.IPAddr, 0, len(ips))
+	for _, ip := range ips {
+		addrs = append(addrs, net.IPAddr{IP: net.ParseIP(ip)})
+	}
+	return addrs, nil
+}
+
+func TestIsPublicIP(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"", false},
+		{"foo", false},
+		{"0.0.0.0", false},
+		{"127.0.0.1", false},
+		{"127.1", false},
+		{"2130706433", false},
+		{"0x7f000001", false},
+		{"0177.0.0.1", false},
+		{"10.1.2.3", false},
+		{"172.16.0.1", false},
+		{"192.168.1.1", false},
+		{"169.254.169.254", false},
+		{"100.64.0.1", false},
+		{"224.0.0.1", false},
+		{"255.255.255.255", false},
+		{"::", false},
+		{"::1", false},
+		{"[::1]", false},
+		{"::ffff:127.0.0.1", false},
+		{"fe80::1%eth0", false},
+		{"fc00::1", false},
+		{"ff02::1", false},
+		{"2001:db8::1", false},
+		{"64:ff9b::a00:1", false},
+		{"2002:c0a8:101::1", false},
+		{"::127.0.0.1", false},
+		{"::8.8.8.8", false},
+		{"64:ff9b:1::7f00:1", false},
+		{"64:ff9b:1::a00:1", false},
+		{"1.2.3.4.5", false},
+		{"256.0.0.1", false},
+		{"08.0.0.1", false},
+
+		{"8.8.8.8", true},
+		{"134744072", true},
+		{"1.1.1.1.", true},
+		{"2606:4700:4700::1111", true},
+		{"64:ff9b::808:808", true},
+		{"64:ff9b:1::808:808", true},
+	}
+	for _, test := range tests {
+		actual := IsPublicIP(test.param)
+		if actual != test.expected {
+			t.Errorf("Expected IsPublicIP(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestIsPublicURL(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"", false},
+		{"foo", false},
+		{"8.8.8.8", false},
+		{"ftp://8.8.8.8/", false},
+		{"http://127.0.0.1/", false},
+		{"http://10.0.0.1:8080/admin", false},
+		{"http://169.254.169.254/latest/meta-data", false},
+		{"http://[::1]/", false},
+		{"http://[::ffff:127.0.0.1]/", false},
+		{"http://2130706433/", false},
+		{"http://0x7f.1/", false},
+
+		{"http://8.8.8.8/", true},
+		{"https://1.1.1.1:443/dns-query", true},
+		{"http://[2606:4700:4700::1111]/", true},
+		{"http://134744072/", true},
+	}
+	for _, test := range tests {
+		actual := IsPublicURL(test.param)
+		if actual != test.expected {
+			t.Errorf("Expected IsPublicURL(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestIsPublicURLWith(t *testing.T) {
+	t.Parallel()
+
+	checker := &SafeURLChecker{Resolver: stubResolver{
+		"example.com": {"93.184.216.34"},
+		"localhost":   {"127.0.0.1"},
+	}}
+
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"http://localhost/", false},
+		{"http://unknown.example/", false},
+
+		{"http://example.com/hook", true},
+	}
+	for _, test := range tests {
+		actual := IsPublicURLWith(context.Background(), test.param, checker)
+		if actual != test.expected {
+			t.Errorf("Expected IsPublicURLWith(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestSafeURLCheckerCheck(t *testing.T) {
+	t.Parallel()
+
+	checker := &SafeURLChecker{Resolver: stubResolver{
+		"example.com":    {"93.184.216.34"},
+		"localhost":      {"127.0.0.1"},
+		"rebind.example": {"93.184.216.34", "10.0.0.1"},
+		"empty.example":  {},
+	}}
+
+	var tests = []struct {
+		param    string
+		expected error
+	}{
+		{"http://example.com/hook", nil},
+		{"https://EXAMPLE.com./hook", nil},
+		{"http://8.8.8.8/", nil},
+
+		{"://", ErrUnsafeURL},
+		{"ftp://example.com/", ErrUnsafeURL},
+		{"http:///path", ErrUnsafeURL},
+		{"http://unknown.example/", ErrUnsafeURL},
+		{"http://empty.example/", ErrUnsafeURL},
+		{"http://localhost/", ErrNonPublicAddress},
+		{"http://127.0.0.1/", ErrNonPublicAddress},
+		{"http://169.254.169.254/latest/meta-data", ErrNonPublicAddress},
+		{"http://[::1]/", ErrNonPublicAddress},
+		{"http://2130706433/", ErrNonPublicAddress},
+		{"http://0x7f.1/", ErrNonPublicAddress},
+		{"http://rebind.example/", ErrNonPublicAddress},
+	}
+	for _, test := range tests {
+		err := checker.Check(context.Background(), test.param)
+		if !errors.Is(err, test.expected) || (test.expected == nil) != (err == nil) {
+			t.Errorf("Expected SafeURLChecker.Check(%q) to return %v, got %v", test.param, test.expected, err)
+		}
+	}
+}
+
+func TestSafeURLCheckerControl(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"127.0.0.1:80", false},
+		{"[::1]:443", false},
+		{"10.0.0.1:8080", false},
+		{"example.com:80", false},
+		{"127.0.0.1", false},
+
+		{"93.184.216.34:80", true},
+		{"[2606:4700:4700::1111]:443", true},
+	}
+	checker := &SafeURLChecker{}
+	for _, test := range tests {
+		actual := checker.Control("tcp", test.param, nil) == nil
+		if actual != test.expected {
+			t.Errorf("Expected SafeURLChecker.Control(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+}
@@ -0,0 +1,601 @@
+package validator
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+)
+
+const sanitizeTagName = "sanitize"
+
+// tagSanitizers maps the names of the sanitize tag to sanitizers.
+var tagSanitizers = map[string]func(str string) string{
+	"escape":         Escape,
+	"lower":          strings.ToLower,
+	"ltrim":          func(str string) string { return LeftTrim(str, "") },
+	"normalizespace": NormalizeWhitespace,
+	"rtrim":          func(str string) string { return RightTrim(str, "") },
+	"striplow":       func(str string) string { return StripLow(str, false) },
+	"trim":           func(str string) string { return Trim(str, "") },
+	"upper":          strings.ToUpper,
+}
+
+// ValidateStruct checks the exported fields of s, a struct or a pointer to a
+// struct, against the rules of their valid tag, e.g. `valid:"required,email"`.
+// Rules name validators of TagMap such as email, url or alpha, or validators
+// of ParamTagMap with their parameters such as length(5|20) or in(a|b|c);
+// required rejects zero values, empty slices and nil pointers, but not
+// pointers to zero values, and the other rules are skipped for empty strings. A tag of "-" skips the field. Rules
+// may be limited to validation groups, see Groups.
+//
+// Typed rules check numbers, strings, times and collections: min(1), max(10)
+// and between(1|10) compare numbers and times with the parameters, times given
+// in RFC 3339, and are rejected on strings, for which range(1|10) compares
+// numeric values; oneof(a|b|c) checks for one of the parameters; len(3) and len(1|5) check
+// the number of elements, or of runes of a string; unique checks that the
+// elements of a slice or the values of a map are unique.
+//
+// Nested structs, pointers, slices, arrays and maps are walked. The rules of
+// a collection apply to each of its elements, except len and unique.
+// Failures are returned as Errors, one FieldError per rule, with the path of
+// the field, e.g. Items[3].Address.Zip. A nil s is valid.
+func ValidateStruct(s interface{}, opts ...Option) error {
+	if s == nil {
+		return nil
+	}
+
+	val := reflect.ValueOf(s)
+	for val.Kind() == reflect.Pointer {
+		if val.IsNil() {
+			return nil
+		}
+		val = val.Elem()
+	}
+	if val.Kind() != reflect.Struct {
+		return fmt.Errorf("validator: ValidateStruct expects a struct, got %T", s)
+	}
+
+	return newStructWalker(opts).run(val)
+}
+
+// ValidateAndSanitize applies the sanitizers of the sanitize tag of the string
+// fields of the struct ptr points to, e.g. `sanitize:"trim,lower"`, in place
+// and in order, then validates the sanitized struct as ValidateStruct does.
+// Strings held in slices, arrays, maps and interfaces are sanitized as well.
+// Sanitizers are trim, ltrim, rtrim, lower, upper, striplow, escape and
+// normalizespace.
+func ValidateAndSanitize(ptr interface{}, opts ...Option) error {
+	val := reflect.ValueOf(ptr)
+	if val.Kind() != reflect.Pointer || val.IsNil() || val.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("validator: ValidateAndSanitize expects a non-nil pointer to a struct, got %T", ptr)
+	}
+
+	w := newStructWalker(opts)
+	w.sanitize = true
+	return w.run(val.Elem())
+}
+
+// Option configures ValidateStruct and ValidateAndSanitize.
+type Option func(w *structWalker)
+
+// Groups returns an Option selecting validation groups, or scenarios. A rule
+// of the valid tag followed by groups, as in `valid:"required~create,email"`
+// or `valid:"required~create~import"`, applies only when one of its groups is
+// selected; rules without groups always apply.
+func Groups(groups ...string) Option {
+	return func(w *structWalker) {
+		w.groups = append(w.groups, groups...)
+	}
+}
+
+// fieldRules holds the parsed tags of a field.
+type fieldRules struct {
+	required   bool
+	validators []tagValidator
+	sanitizers []func(str string) string
+}
+
+// tagValidator is a rule of the valid tag: a validator of strings, or a
+// typed rule checked on the value itself, limited to groups if not nil.
+type tagValidator struct {
+	name      string
+	param     string
+	validator Validator
+	typed     *typedTagRule
+	params    []string
+	groups    []string
+}
+
+// structWalker walks a struct value and collects the failures of its fields.
+type structWalker struct {
+	sanitize  bool
+	fieldName FieldNameFunc
+	groups    []string
+	errs      Errors
+}
+
+func newStructWalker(opts []Option) *structWalker {
+	w := &structWalker{}
+	for _, opt := range opts {
+		opt(w)
+	}
+
+	return w
+}
+
+func (w *structWalker) run(val reflect.Value) error {
+	if err := w.walkStruct(val, fieldPath{}); err != nil {
+		return err
+	}
+
+	return w.errs.errOrNil()
+}
+
+func (w *structWalker) fail(val reflect.Value, loc fieldPath, rule, param string) {
+	var value interface{}
+	if val.IsValid() && val.CanInterface() {
+		value = val.Interface()
+	}
+
+	w.errs = append(w.errs, newFieldError(loc, rule, param, value))
+}
+
+func (w *structWalker) walkStruct(val reflect.Value, loc fieldPath) error {
+	typ := val.Type()
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if field.Tag.Get(tagName) == "-" || !field.IsExported() && !isEmbeddedStruct(field) {
+			continue
+		}
+
+		fieldLoc := loc.field(field, w.name(field))
+		rules, err := w.parseRules(typ, i, fieldLoc.path)
+		if err != nil {
+			return err
+		}
+		if err := w.walkValue(val.Field(i), rules, fieldLoc); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// inGroups reports whether one of groups is selected.
+func (w *structWalker) inGroups(groups []string) bool {
+	for _, group := range groups {
+		if slices.Contains(w.groups, group) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// name returns the name of field in paths.
+func (w *structWalker) name(field reflect.StructField) string {
+	if w.fieldName != nil {
+		if name := w.fieldName(field); name != "" {
+			return name
+		}
+	}
+
+	return field.Name
+}
+
+// parseRules returns the rules of the tags of the field i of typ that apply to
+// the selected groups.
+func (w *structWalker) parseRules(typ reflect.Type, i int, path string) (fieldRules, error) {
+	tags, err := cachedFieldTags(typ, i)
+	if err != nil {
+		return fieldRules{}, fmt.Errorf("validator: %s: %w", path, err)
+	}
+
+	var rules fieldRules
+	for _, rule := range tags.validators {
+		if rule.groups != nil && !w.inGroups(rule.groups) {
+			continue
+		}
+		if rule.name == "required" {
+			rules.required = true
+			continue
+		}
+		rules.validators = append(rules.validators, rule)
+	}
+
+	if w.sanitize {
+		if tags.sanitizeErr != nil {
+			return rules, fmt.Errorf("validator: %s: %w", path, tags.sanitizeErr)
+		}
+		rules.sanitizers = tags.sanitizers
+	}
+
+	return rules, nil
+}
+
+// fieldTags holds the parsed valid and sanitize tags of a struct field.
+type fieldTags struct {
+	validators  []tagValidator
+	sanitizers  []func(str string) string
+	sanitizeErr error
+}
+
+type fieldKey struct {
+	typ   reflect.Type
+	index int
+}
+
+// fieldTagsCache maps fieldKeys to the *fieldTags of the fields, so that the
+// tags of each field are parsed once.
+var fieldTagsCache sync.Map
+
+// cachedFieldTags returns the parsed tags of the field i of typ. Fields whose
+// valid tag is invalid are not cached.
+func cachedFieldTags(typ reflect.Type, i int) (*fieldTags, error) {
+	key := fieldKey{typ, i}
+	if tags, ok := fieldTagsCache.Load(key); ok {
+		return tags.(*fieldTags), nil
+	}
+
+	tags, err := parseFieldTags(typ.Field(i))
+	if err != nil {
+		return nil, err
+	}
+	fieldTagsCache.Store(key, tags)
+
+	return tags, nil
+}
+
+func parseFieldTags(field reflect.StructField) (*fieldTags, error) {
+	tags := &fieldTags{}
+	for _, name := range splitTag(field.Tag.Get(tagName)) {
+		name, groups := cutGroups(name)
+		if slices.Contains(groups, "") {
+			return nil, fmt.Errorf("empty group in rule %q", name)
+		}
+
+		rule := tagValidator{name: name}
+		if name != "required" {
+			var err error
+			if rule, err = parseTagValidator(name); err != nil {
+				return nil, err
+			}
+		}
+		rule.groups = groups
+		tags.validators = append(tags.validators, rule)
+	}
+
+	for _, name := range splitTag(field.Tag.Get(sanitizeTagName)) {
+		sanitizer, ok := tagSanitizers[name]
+		if !ok {
+			tags.sanitizeErr = fmt.Errorf("unknown sanitizer %q", name)
+			break
+		}
+		tags.sanitizers = append(tags.sanitizers, sanitizer)
+	}
+
+	return tags, nil
+}
+
+func (w *structWalker) walkValue(val reflect.Value, rules fieldRules, loc fieldPath) error {
+	switch val.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if val.IsNil() {
+			if rules.required {
+				w.fail(val, loc, "required", "")
+			}
+			return nil
+		}
+		if val.Kind() == reflect.Interface && w.sanitize && val.CanSet() {
+			// The value held by an interface cannot be set, so a copy is
+			// sanitized and stored back.
+			elem := reflect.New(val.Elem().Type()).Elem()
+			elem.Set(val.Elem())
+			if err := w.walkValue(elem, rules, loc); err != nil {
+				return err
+			}
+			val.Set(elem)
+			return nil
+		}
+		if val.Kind() == reflect.Pointer {
+			// A non-nil pointer is set, even to a zero value.
+			rules.required = false
+		}
+		return w.walkValue(val.Elem(), rules, loc)
+
+	case reflect.Struct:
+		if val.Type() == timeType {
+			break
+		}
+		if len(rules.validators) > 0 {
+			return fmt.Errorf("validator: %s: rule %q cannot be applied to %s", loc.path, rules.validators[0].name, val.Type())
+		}
+		if rules.required && val.IsZero() {
+			w.fail(val, loc, "required", "")
+			return nil
+		}
+		if loc.embedded != nil {
+			return w.walkStruct(val, *loc.embedded)
+		}
+		return w.walkStruct(val, loc)
+
+	case reflect.Slice, reflect.Array, reflect.Map:
+		if rules.required && val.Len() == 0 {
+			w.fail(val, loc, "required", "")
+			return nil
+		}
+
+		// Rules on the collection, such as len or unique, are checked here and
+		// the other rules on each element.
+		elem := fieldRules{sanitizers: rules.sanitizers}
+		for _, rule := range rules.validators {
+			if rule.typed == nil || !rule.typed.collection {
+				elem.validators = append(elem.validators, rule)
+			} else if err := w.checkTyped(val, rule, loc); err != nil {
+				return err
+			}
+		}
+
+		if val.Kind() == reflect.Map {
+			for _, key := range sortedMapKeys(val) {
+				if !w.sanitize {
+					if err := w.walkValue(val.MapIndex(key), elem, loc.key(key)); err != nil {
+						return err
+					}
+					continue
+				}
+
+				// Map values cannot be set, so a copy is sanitized and stored back.
+				value := reflect.New(val.Type().Elem()).Elem()
+				value.Set(val.MapIndex(key))
+				if err := w.walkValue(value, elem, loc.key(key)); err != nil {
+					return err
+				}
+				val.SetMapIndex(key, value)
+			}
+			return nil
+		}
+		for i := 0; i < val.Len(); i++ {
+			if err := w.walkValue(val.Index(i), elem, loc.index(i)); err != nil {
+				return err
+			}
+		}
+		return nil
+
+	case reflect.String:
+		if len(rules.sanitizers) > 0 {
+			if !val.CanSet() {
+				return fmt.Errorf("validator: %s: cannot sanitize %s, it is not settable", loc.path, val.Type())
+			}
+			str := val.String()
+			for _, sanitizer := range rules.sanitizers {
+				str = sanitizer(str)
+			}
+			val.SetString(str)
+		}
+
+		// Strings would compare lexicographically, so that min(18) rejects "100".
+		for _, rule := range rules.validators {
+			if rule.typed != nil && rule.typed.ordering {
+				return fmt.Errorf("validator: %s: rule %q cannot be applied to %s, see range for numeric strings", loc.path, rule.name, val.Type())
+			}
+		}
+
+		str := val.String()
+		if str == "" {
+			if rules.required {
+				w.fail(val, loc, "required", "")
+			}
+			return nil
+		}
+		for _, rule := range rules.validators {
+			if rule.typed != nil {
+				if err := w.checkTyped(val, rule, loc); err != nil {
+					return err
+				}
+			} else if !rule.validator(str) {
+				w.fail(val, loc, rule.name, rule.param)
+			}
+		}
+		return nil
+	}
+
+	// Numbers, booleans and times only accept typed rules.
+	for _, rule := range rules.validators {
+		if rule.typed == nil || rule.typed.collection {
+			return fmt.Errorf("validator: %s: rule %q cannot be applied to %s", loc.path, rule.name, val.Type())
+		}
+	}
+	if rules.required && val.IsZero() {
+		w.fail(val, loc, "required", "")
+		return nil
+	}
+	for _, rule := range rules.validators {
+		if err := w.checkTyped(val, rule, loc); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (w *structWalker) checkTyped(val reflect.Value, rule tagValidator, loc fieldPath) error {
+	ok, err := rule.typed.check(val, rule.params)
+	if err != nil {
+		return fmt.Errorf("validator: %s: rule %q %w", loc.path, rule.name, err)
+	}
+	if !ok {
+		w.fail(val, loc, rule.name, rule.param)
+	}
+
+	return nil
+}
+
+// sortedMapKeys returns the keys of a map in a stable order for reporting.
+func sortedMapKeys(val reflect.Value) []reflect.Value {
+	keys := val.MapKeys()
+	sort.Slice(keys, func(i, j int) bool {
+		return fmt.Sprint(keys[i]) < fmt.Sprint(keys[j])
+	})
+
+	return keys
+}
+
+// isEmbeddedStruct reports whether field embeds a struct or a pointer to a
+// struct, whose exported fields are walked even if its type is unexported.
+func isEmbeddedStruct(field reflect.StructField) bool {
+	typ := field.Type
+	if typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+
+	return field.Anonymous && typ.Kind() == reflect.Struct
+}
+
+// parseTagValidator looks up a rule of the valid tag: a typed rule such as
+// min(1) or unique, a name of TagMap, or a name of ParamTagMap followed by its
+// parameters in parentheses.
+func parseTagValidator(rule string) (tagValidator, error) {
+	name, param, hasParam := strings.Cut(rule, "(")
+	if hasParam {
+		var ok bool
+		if param, ok = strings.CutSuffix(param, ")"); !ok {
+			return tagValidator{}, fmt.Errorf("malformed rule %q", rule)
+		}
+	}
+
+	if typed, ok := typedTagRules[name]; ok {
+		var params []string
+		if hasParam {
+			params = strings.Split(param, "|")
+		}
+		return tagValidator{name: name, param: param, typed: &typed, params: params}, nil
+	}
+
+	if !hasParam {
+		validator, ok := TagMap.Get(name)
+		if !ok {
+			return tagValidator{}, fmt.Errorf("unknown rule %q", rule)
+		}
+		return tagValidator{name: name, validator: validator}, nil
+	}
+
+	if name == "matches" {
+		r, err := regexp.Compile(param)
+		if err != nil {
+			return tagValidator{}, fmt.Errorf("invalid pattern in rule %q: %v", rule, err)
+		}
+		return tagValidator{name: name, param: param, validator: r.MatchString}, nil
+	}
+
+	validator, ok := ParamTagMap.Get(name)
+	if !ok {
+		return tagValidator{}, fmt.Errorf("unknown rule %q", rule)
+	}
+	params := strings.Split(param, "|")
+
+	return tagValidator{name: name, param: param, validator: func(str string) bool {
+		return validator(str, params...)
+	}}, nil
+}
+
+// cutGroups splits a rule of the valid tag from the groups following it, as
+// in required~create. A "~" inside the parameters of the rule, such as that of
+// matches(^a~b$), is part of the rule.
+func cutGroups(rule string) (string, []string) {
+	start := strings.LastIndex(rule, ")") + 1
+	i := strings.Index(rule[start:], "~")
+	if i < 0 {
+		return rule, nil
+	}
+
+	return rule[:start+i], strings.Split(rule[start+i+1:], "~")
+}
+
+// splitTag splits a tag value into its comma-separated rules, ignoring the
+// commas inside parentheses such as those of matches(^[a-z]{1,3}$).
+func splitTag(tag string) []string {
+	var rules []string
+	depth, start := 0, 0
+	for i := 0; i <= len(tag); i++ {
+		switch {
+		case i == len(tag) || tag[i] == ',' && depth == 0:
+			if rule := strings.TrimSpace(tag[start:i]); rule != "" {
+				rules = append(rules, rule)
+			}
+			start = i + 1
+		case tag[i] == '(':
+			depth++
+		case tag[i] == ')' && depth > 0:
+			depth--
+		}
+	}
+
+	return rules
+}
+
+// fieldPath locates a value in a validated struct, both as a Go path such as
+// Items[3].Address.Zip and as a JSON pointer such as /items/3/address/zip.
+type fieldPath struct {
+	path    string
+	pointer string
+	// jsonPath is the path of the JSON form of the struct, built from json
+	// tag names, e.g. items[3].address.zip.
+	jsonPath string
+	// embedded is the location of the fields of an embedded struct, that of
+	// the outer struct, or nil.
+	embedded *fieldPath
+}
+
+// field returns the location of a field of the struct at p, named name in
+// the path. Embedded fields are named after their type, but the fields of
+// embedded structs are located as fields of the outer struct, as
+// encoding/json does unless the embedded struct is named by a json tag.
+func (p fieldPath) field(field reflect.StructField, name string) fieldPath {
+	jsonName, tagged := tagFieldName(field, "json")
+	loc := fieldPath{
+		path:     joinPath(p.path, name),
+		pointer:  p.pointer + "/" + escapePointer(jsonName),
+		jsonPath: joinPath(p.jsonPath, jsonName),
+	}
+	if isEmbeddedStruct(field) && !tagged {
+		loc.embedded = &p
+	}
+
+	return loc
+}
+
+// index returns the location of an element of the slice or array at p.
+func (p fieldPath) index(i int) fieldPath {
+	return fieldPath{
+		path:     fmt.Sprintf("%s[%d]", p.path, i),
+		pointer:  fmt.Sprintf("%s/%d", p.pointer, i),
+		jsonPath: fmt.Sprintf("%s[%d]", p.jsonPath, i),
+	}
+}
+
+// key returns the location of a value of the map at p.
+func (p fieldPath) key(key reflect.Value) fieldPath {
+	return fieldPath{
+		path:     fmt.Sprintf("%s[%v]", p.path, key),
+		pointer:  p.pointer + "/" + escapePointer(fmt.Sprint(key)),
+		jsonPath: fmt.Sprintf("%s[%v]", p.jsonPath, key),
+	}
+}
+
+// escapePointer escapes a reference token of a JSON pointer (RFC 6901).
+func escapePointer(token string) string {
+	return strings.NewReplacer("~", "~0", "/", "~1").Replace(token)
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+
+	return path + "." + name
+}
@@ -0,0 +1,252 @@
+package validator
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type testAddress struct {
+	Street string `valid:"required"`
+	Zip    string `valid:"numeric"`
+}
+
+type testItem struct {
+	Name    string `valid:"required,alphanum"`
+	Address *testAddress
+}
+
+type testTimestamps struct {
+	Created string `valid:"numeric"`
+}
+
+type testOrder struct {
+	testTimestamps
+	Email   string   `valid:"required,email"`
+	Website string   `valid:"url"`
+	Tags    []string `valid:"alpha"`
+	Items   []testItem
+	Billing testAddress
+	Notes   []string `valid:"required"`
+	Count   int      `valid:"required"`
+	Skipped string   `valid:"-"`
+	secret  string
+}
+
+// errorMessages returns the messages of the errors joined in err.
+func errorMessages(err error) []string {
+	if err == nil {
+		return nil
+	}
+
+	var messages []string
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		for _, err := range joined.Unwrap() {
+			messages = append(messages, err.Error())
+		}
+		return messages
+	}
+
+	return []string{err.Error()}
+}
+
+func TestValidateStruct(t *testing.T) {
+	t.Parallel()
+
+	valid := testOrder{
+		testTimestamps: testTimestamps{Created: "1700000000"},
+		Email:          "foo@bar.com",
+		Tags:           []string{"abc", ""},
+		Items:          []testItem{{Name: "item1", Address: &testAddress{Street: "Main", Zip: "12345"}}},
+		Billing:        testAddress{Street: "Main"},
+		Notes:          []string{"note"},
+		Count:          1,
+		Skipped:        "not validated",
+		secret:         "not validated",
+	}
+
+	var tests = []struct {
+		param    interface{}
+		expected []string
+	}{
+		{nil, nil},
+		{(*testOrder)(nil), nil},
+		{valid, nil},
+		{&valid, nil},
+		{testOrder{
+			testTimestamps: testTimestamps{Created: "yesterday"},
+			Email:          "foo",
+			Website:        "not a url",
+			Tags:           []string{"abc", "a1"},
+			Items: []testItem{
+				{Name: "ok"},
+				{Name: "", Address: &testAddress{Zip: "ABC"}},
+			},
+		}, []string{
+			"validator: Created does not validate as numeric",
+			"validator: Email does not validate as email",
+			"validator: Website does not validate as url",
+			"validator: Tags[1] does not validate as alpha",
+			"validator: Items[1].Name is required",
+			"validator: Items[1].Address.Street is required",
+			"validator: Items[1].Address.Zip does not validate as numeric",
+			"validator: Billing.Street is required",
+			"validator: Notes is required",
+			"validator: Count is required",
+		}},
+	}
+	for _, test := range tests {
+		actual := errorMessages(ValidateStruct(test.param))
+		if !reflect.DeepEqual(actual, test.expected) {
+			t.Errorf("Expected ValidateStruct(%#v) to fail with %q, got %q", test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestValidateStructRequiredPointers(t *testing.T) {
+	t.Parallel()
+
+	type patch struct {
+		Count   *int         `valid:"required"`
+		Enabled *bool        `valid:"required"`
+		Name    *string      `valid:"required"`
+		Address *testAddress `valid:"required"`
+	}
+
+	count, enabled, name := 0, false, ""
+	if actual := errorMessages(ValidateStruct(patch{Count: &count, Enabled: &enabled, Name: &name, Address: &testAddress{}})); !reflect.DeepEqual(actual, []string{
+		"validator: Address.Street is required",
+	}) {
+		t.Errorf("Expected pointers to zero values to be set, got %q", actual)
+	}
+
+	if actual := errorMessages(ValidateStruct(patch{})); !reflect.DeepEqual(actual, []string{
+		"validator: Count is required",
+		"validator: Enabled is required",
+		"validator: Name is required",
+		"validator: Address is required",
+	}) {
+		t.Errorf("Expected nil pointers to be required, got %q", actual)
+	}
+}
+
+type TestRef string
+
+type TestAudit struct {
+	By string `valid:"required"`
+}
+
+func TestValidateStructEmbedded(t *testing.T) {
+	t.Parallel()
+
+	type record struct {
+		TestRef    `valid:"email"`
+		*TestAudit `valid:"required"`
+	}
+
+	if actual := errorMessages(ValidateStruct(record{TestRef: "foo"})); !reflect.DeepEqual(actual, []string{
+		"validator: TestRef does not validate as email",
+		"validator: TestAudit is required",
+	}) {
+		t.Errorf("Expected embedded fields to be named after their type, got %q", actual)
+	}
+
+	var fieldErr *FieldError
+	if err := ValidateStruct(record{TestRef: "foo@bar.com", TestAudit: &TestAudit{}}); !errors.As(err, &fieldErr) ||
+		fieldErr.Path != "By" || fieldErr.Pointer != "/By" {
+		t.Errorf("Expected the fields of embedded structs to be flattened, got %v", err)
+	}
+}
+
+func TestValidateStructInvalidUsage(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    interface{}
+		expected string
+	}{
+		{"string", "expects a struct"},
+		{struct {
+			Name string `valid:"unknown"`
+		}{}, `Name: unknown rule "unknown"`},
+		{struct {
+			Age int `valid:"numeric"`
+		}{}, `Age: rule "numeric" cannot be applied to int`},
+	}
+	for _, test := range tests {
+		err := ValidateStruct(test.param)
+		if err == nil || !strings.Contains(err.Error(), test.expected) {
+			t.Errorf("Expected ValidateStruct(%#v) to fail with %q, got %v", test.param, test.expected, err)
+		}
+	}
+}
+
+func TestValidateAndSanitize(t *testing.T) {
+	t.Parallel()
+
+	type request struct {
+		Email string   `valid:"required,email" sanitize:"trim,lower"`
+		Name  string   `sanitize:"normalizespace"`
+		Bio   *string  `sanitize:"striplow,escape"`
+		Tags  []string `valid:"alpha" sanitize:"trim"`
+		Inner struct {
+			Code string `valid:"required,alphanum" sanitize:"trim,upper"`
+		}
+	}
+
+	bio := "<b>\x00hi</b>"
+	req := request{Email: "  Foo@Bar.COM\n", Name: "  John \t Smith ", Bio: &bio, Tags: []string{" go ", "rust "}}
+	req.Inner.Code = "  ab12 "
+	if err := ValidateAndSanitize(&req); err != nil {
+		t.Fatalf("Expected ValidateAndSanitize to succeed, got %v", err)
+	}
+	if req.Email != "foo@bar.com" || req.Name != "John Smith" || bio != "&lt;b&gt;hi&lt;/b&gt;" ||
+		!reflect.DeepEqual(req.Tags, []string{"go", "rust"}) || req.Inner.Code != "AB12" {
+		t.Errorf("Expected the fields to be sanitized, got %+v, %q", req, bio)
+	}
+
+	req = request{Email: "   "}
+	if actual := errorMessages(ValidateAndSanitize(&req)); !reflect.DeepEqual(actual, []string{
+		"validator: Email is required",
+		"validator: Inner.Code is required",
+	}) {
+		t.Errorf("Expected whitespace-only fields to be required after trimming, got %q", actual)
+	}
+
+	if err := ValidateAndSanitize(request{}); err == nil {
+		t.Errorf("Expected ValidateAndSanitize of a struct value to fail")
+	}
+
+	type user struct {
+		Name string `valid:"alpha" sanitize:"trim"`
+	}
+	var nested struct {
+		Labels map[string]string `valid:"alpha" sanitize:"trim"`
+		Users  map[string]user
+		Extra  interface{}            `valid:"alpha" sanitize:"trim"`
+		Meta   map[string]interface{} `valid:"alpha" sanitize:"upper"`
+	}
+	nested.Labels = map[string]string{"a": "  abc  "}
+	nested.Users = map[string]user{"u": {Name: " ann "}}
+	nested.Extra = " xyz "
+	nested.Meta = map[string]interface{}{"m": "def"}
+	if err := ValidateAndSanitize(&nested); err != nil {
+		t.Fatalf("Expected map and interface values to be sanitized, got %v", err)
+	}
+	if nested.Labels["a"] != "abc" || nested.Users["u"].Name != "ann" || nested.Extra != "xyz" || nested.Meta["m"] != "DEF" {
+		t.Errorf("Expected map and interface values to be sanitized, got %+v", nested)
+	}
+
+	var unknown struct {
+		Name string `sanitize:"reverse"`
+	}
+	if err := ValidateAndSanitize(&unknown); err == nil || !strings.Contains(err.Error(), `unknown sanitizer "reverse"`) {
+		t.Errorf("Expected an unknown sanitizer to fail, got %v", err)
+	}
+
+	var fieldErr *FieldError
+	if err := ValidateStruct(request{}); !errors.As(err, &fieldErr) || fieldErr.Path != "Email" {
+		t.Errorf("Expected ValidateStruct to return field errors, got %v", err)
+	}
+}
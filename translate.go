@@ -0,0 +1,174 @@
+package validator
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// enMessages holds the English message templates, keyed by rule name. The key
+// "" holds the template of rules without their own.
+var enMessages = map[string]string{
+	"":               "{field} does not validate as {rule}",
+	"required":       "{field} is required",
+	"alpha":          "{field} must contain only letters",
+	"alphanum":       "{field} must contain only letters and numbers",
+	"ascii":          "{field} must contain only ASCII characters",
+	"email":          "{field} must be a valid email address",
+	"fullwidth":      "{field} must contain full-width characters",
+	"halfwidth":      "{field} must contain half-width characters",
+	"multibyte":      "{field} must contain multibyte characters",
+	"numeric":        "{field} must contain only digits",
+	"printableascii": "{field} must contain only printable ASCII characters",
+	"publicurl":      "{field} must be a public URL",
+	"requri":         "{field} must be a valid request URI",
+	"requrl":         "{field} must be a valid request URL",
+	"uritemplate":    "{field} must be a valid URI template",
+	"url":            "{field} must be a valid URL",
+	"utfdigit":       "{field} must contain only digits",
+	"utfletter":      "{field} must contain only letters",
+	"utfletternum":   "{field} must contain only letters and numbers",
+	"utfnumeric":     "{field} must contain only numbers",
+	"variablewidth":  "{field} must mix full-width and half-width characters",
+	"length":         "{field} must be between {0} and {1} bytes long",
+	"runelength":     "{field} must be between {0} and {1} characters long",
+	"minrunes":       "{field} must be at least {0} characters long",
+	"maxrunes":       "{field} must be at most {0} characters long",
+	"range":          "{field} must be between {0} and {1}",
+	"in":             "{field} must be one of {params}",
+	"matches":        "{field} must match the pattern {param}",
+	"min":            "{field} must be at least {0}",
+	"max":            "{field} must be at most {0}",
+	"between":        "{field} must be between {0} and {1}",
+	"oneof":          "{field} must be one of {params}",
+	"len":            "{field} must have a length between {0} and {1}",
+	"len#1":          "{field} must have a length of exactly {0}",
+	"unique":         "{field} must contain unique values",
+}
+
+// zhMessages holds the Simplified Chinese message templates.
+var zhMessages = map[string]string{
+	"":               "{field}未通过{rule}校验",
+	"required":       "{field}不能为空",
+	"alpha":          "{field}只能包含字母",
+	"alphanum":       "{field}只能包含字母和数字",
+	"ascii":          "{field}只能包含ASCII字符",
+	"email":          "{field}必须是有效的电子邮件地址",
+	"fullwidth":      "{field}必须包含全角字符",
+	"halfwidth":      "{field}必须包含半角字符",
+	"multibyte":      "{field}必须包含多字节字符",
+	"numeric":        "{field}只能包含数字",
+	"printableascii": "{field}只能包含可打印的ASCII字符",
+	"publicurl":      "{field}必须是公网URL",
+	"requri":         "{field}必须是有效的请求URI",
+	"requrl":         "{field}必须是有效的请求URL",
+	"uritemplate":    "{field}必须是有效的URI模板",
+	"url":            "{field}必须是有效的URL",
+	"utfdigit":       "{field}只能包含数字",
+	"utfletter":      "{field}只能包含文字",
+	"utfletternum":   "{field}只能包含文字和数字",
+	"utfnumeric":     "{field}只能包含数字",
+	"variablewidth":  "{field}必须同时包含全角和半角字符",
+	"length":         "{field}的长度必须在{0}到{1}个字节之间",
+	"runelength":     "{field}的长度必须在{0}到{1}个字符之间",
+	"minrunes":       "{field}至少需要{0}个字符",
+	"maxrunes":       "{field}最多只能有{0}个字符",
+	"range":          "{field}必须在{0}到{1}之间",
+	"in":             "{field}必须是{params}之一",
+	"matches":        "{field}必须匹配格式{param}",
+	"min":            "{field}不能小于{0}",
+	"max":            "{field}不能大于{0}",
+	"between":        "{field}必须在{0}到{1}之间",
+	"oneof":          "{field}必须是{params}之一",
+	"len":            "{field}的长度必须在{0}到{1}之间",
+	"len#1":          "{field}的长度必须为{0}",
+	"unique":         "{field}不能包含重复的值",
+}
+
+var translations = struct {
+	sync.RWMutex
+	bundles map[string]map[string]string
+}{bundles: map[string]map[string]string{
+	"en": enMessages,
+	"zh": zhMessages,
+}}
+
+// RegisterTranslations adds the message templates of messages, keyed by rule
+// name, to the bundle of locale, e.g. "fr-FR" or "zh", creating it if needed
+// and replacing the templates already registered for the same rules.
+//
+// Templates may use the placeholders {field} for the path of the field,
+// {rule} for the rule name, {param} for the parameters of the rule as written
+// in the tag, {params} for the parameters separated by ", ", and {0}, {1}...
+// for each parameter. A template keyed by the rule name followed by "#" and a
+// number of parameters, such as len#1 for len(3), takes precedence over the
+// template of the rule for failures with that many parameters. The key ""
+// holds the template of rules without their own.
+func RegisterTranslations(locale string, messages map[string]string) {
+	translations.Lock()
+	defer translations.Unlock()
+
+	locale = normalizeLocale(locale)
+	bundle := make(map[string]string, len(translations.bundles[locale])+len(messages))
+	for rule, message := range translations.bundles[locale] {
+		bundle[rule] = message
+	}
+	for rule, message := range messages {
+		bundle[rule] = message
+	}
+	translations.bundles[locale] = bundle
+}
+
+// Translate renders the failure with the message template of its rule in
+// locale, see RegisterTranslations. Templates missing for locale are looked
+// up for its language, then in English.
+func (e *FieldError) Translate(locale string) string {
+	var params []string
+	if e.Param != "" {
+		params = strings.Split(e.Param, "|")
+	}
+
+	replacements := []string{
+		"{field}", e.Path,
+		"{rule}", e.Rule,
+		"{param}", e.Param,
+		"{params}", strings.Join(params, ", "),
+	}
+	for i, param := range params {
+		replacements = append(replacements, "{"+strconv.Itoa(i)+"}", param)
+	}
+
+	return strings.NewReplacer(replacements...).Replace(lookupTemplate(locale, e.Rule, len(params)))
+}
+
+// lookupTemplate returns the message template of rule with n parameters in
+// locale, preferring the default template of the language of locale to the
+// English template of rule.
+func lookupTemplate(locale, rule string, n int) string {
+	translations.RLock()
+	defer translations.RUnlock()
+
+	locale = normalizeLocale(locale)
+	lang, _, _ := strings.Cut(locale, "-")
+	arity := rule + "#" + strconv.Itoa(n)
+	for _, key := range [][2]string{
+		{locale, arity}, {lang, arity}, {locale, rule}, {lang, rule}, {locale, ""}, {lang, ""},
+		{"en", arity}, {"en", rule}, {"en", ""},
+	} {
+		if template, ok := translations.bundles[key[0]][key[1]]; ok {
+			return template
+		}
+	}
+
+	return enMessages[""]
+}
+
+// Translate renders each failure in locale, see FieldError.Translate.
+func (es Errors) Translate(locale string) []string {
+	messages := make([]string, len(es))
+	for i, e := range es {
+		messages[i] = e.Translate(locale)
+	}
+
+	return messages
+}
@@ -0,0 +1,101 @@
+package validator
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestErrorsTranslate(t *testing.T) {
+	t.Parallel()
+
+	type request struct {
+		Email string   `valid:"required,email"`
+		Name  string   `valid:"runelength(2|20)"`
+		Level string   `valid:"in(low|high)"`
+		Code  string   `valid:"matches(^(a|b)$)"`
+		Age   int      `valid:"between(18|120)"`
+		Tags  []string `valid:"len(2)"`
+	}
+
+	var errs Errors
+	if !errors.As(ValidateStruct(request{Name: "x", Level: "mid", Code: "c", Age: 1, Tags: []string{"a"}}), &errs) {
+		t.Fatalf("Expected the request to be invalid")
+	}
+
+	var tests = []struct {
+		locale   string
+		expected []string
+	}{
+		{"en", []string{
+			"Email is required",
+			"Name must be between 2 and 20 characters long",
+			"Level must be one of low, high",
+			"Code must match the pattern ^(a|b)$",
+			"Age must be between 18 and 120",
+			"Tags must have a length of exactly 2",
+		}},
+		{"zh-CN", []string{
+			"Email不能为空",
+			"Name的长度必须在2到20个字符之间",
+			"Level必须是low, high之一",
+			"Code必须匹配格式^(a|b)$",
+			"Age必须在18到120之间",
+			"Tags的长度必须为2",
+		}},
+	}
+	for _, test := range tests {
+		if actual := errs.Translate(test.locale); !reflect.DeepEqual(actual, test.expected) {
+			t.Errorf("Expected Translate(%q) to be %q, got %q", test.locale, test.expected, actual)
+		}
+	}
+
+	for _, locale := range []string{"", "en-US", "xx", "de_DE"} {
+		if actual := errs.Translate(locale); !reflect.DeepEqual(actual, tests[0].expected) {
+			t.Errorf("Expected Translate(%q) to fall back to English, got %q", locale, actual)
+		}
+	}
+	if actual := errs.Translate("zh_cn"); !reflect.DeepEqual(actual, tests[1].expected) {
+		t.Errorf("Expected Translate(%q) to use the Chinese bundle, got %q", "zh_cn", actual)
+	}
+}
+
+func TestRegisterTranslations(t *testing.T) {
+	t.Parallel()
+
+	RegisterTranslations("fr", map[string]string{
+		"":         "{field} n'est pas valide ({rule})",
+		"required": "{field} est obligatoire",
+		"length":   "{field} doit contenir entre {0} et {1} octets",
+	})
+	RegisterTranslations("fr_CA", map[string]string{
+		"required": "{field} est requis",
+	})
+	RegisterTranslations("fr", map[string]string{
+		"length": "{field} : entre {0} et {1} octets",
+	})
+
+	required := &FieldError{Path: "Email", Rule: "required"}
+	length := &FieldError{Path: "Code", Rule: "length", Param: "5|20"}
+	email := &FieldError{Path: "Email", Rule: "email"}
+	custom := &FieldError{Path: "Email", Rule: "test_domain", Param: "example.com"}
+
+	var tests = []struct {
+		err      *FieldError
+		locale   string
+		expected string
+	}{
+		{required, "fr-FR", "Email est obligatoire"},
+		{required, "fr-CA", "Email est requis"},
+		{length, "fr-CA", "Code : entre 5 et 20 octets"},
+		{email, "fr", "Email n'est pas valide (email)"},
+		{custom, "fr", "Email n'est pas valide (test_domain)"},
+		{custom, "en", "Email does not validate as test_domain"},
+		{custom, "zh", "Email未通过test_domain校验"},
+	}
+	for _, test := range tests {
+		if actual := test.err.Translate(test.locale); actual != test.expected {
+			t.Errorf("Expected Translate(%q) of %v to be %q, got %q", test.locale, test.err, test.expected, actual)
+		}
+	}
+}
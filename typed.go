@@ -0,0 +1,247 @@
+package validator
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"slices"
+	"strconv"
+	"time"
+	"unicode/utf8"
+)
+
+// TypedRule is a rule on values of type T, for fields that are not strings.
+type TypedRule[T any] func(v T) bool
+
+// Min returns a rule checking that a value is at least min.
+func Min[T cmp.Ordered](min T) TypedRule[T] {
+	return func(v T) bool {
+		return cmp.Compare(v, min) >= 0
+	}
+}
+
+// Max returns a rule checking that a value is at most max. NaN is invalid.
+func Max[T cmp.Ordered](max T) TypedRule[T] {
+	return func(v T) bool {
+		return v == v && cmp.Compare(v, max) <= 0
+	}
+}
+
+// Between returns a rule checking that a value is between min and max, both
+// inclusive.
+func Between[T cmp.Ordered](min, max T) TypedRule[T] {
+	return func(v T) bool {
+		return cmp.Compare(v, min) >= 0 && cmp.Compare(v, max) <= 0
+	}
+}
+
+// OneOf returns a rule checking that a value is one of values.
+func OneOf[T comparable](values ...T) TypedRule[T] {
+	return func(v T) bool {
+		return slices.Contains(values, v)
+	}
+}
+
+// Len returns a rule checking that the length of a slice, array, map or
+// channel, or the number of runes of a string, is between min and max, both
+// inclusive. Values of other kinds are invalid.
+func Len[S any](min, max int) TypedRule[S] {
+	return func(v S) bool {
+		n, ok := valueLen(reflect.ValueOf(v))
+		return ok && n >= min && n <= max
+	}
+}
+
+// Unique returns a rule checking that the elements of a slice are unique.
+func Unique[T comparable]() TypedRule[[]T] {
+	return func(v []T) bool {
+		seen := make(map[T]bool, len(v))
+		for _, elem := range v {
+			if seen[elem] {
+				return false
+			}
+			seen[elem] = true
+		}
+
+		return true
+	}
+}
+
+// ForEach returns a rule checking that every element of a slice satisfies
+// rule. See Each for lists in strings.
+func ForEach[T any](rule TypedRule[T]) TypedRule[[]T] {
+	return func(v []T) bool {
+		for _, elem := range v {
+			if !rule(elem) {
+				return false
+			}
+		}
+
+		return true
+	}
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// typedTagRule is a rule of the valid tag checked on values of any type
+// through reflection. Rules on collections apply to slices, arrays, maps and
+// strings rather than to their elements. Ordering rules do not apply to
+// strings, which would compare lexicographically.
+type typedTagRule struct {
+	collection bool
+	ordering   bool
+	check      func(val reflect.Value, params []string) (bool, error)
+}
+
+var typedTagRules = map[string]typedTagRule{
+	"min": {ordering: true, check: func(val reflect.Value, params []string) (bool, error) {
+		c, err := compareParams(val, params, 1)
+		return err == nil && c[0] >= 0, err
+	}},
+	"max": {ordering: true, check: func(val reflect.Value, params []string) (bool, error) {
+		c, err := compareParams(val, params, 1)
+		return err == nil && c[0] <= 0 && !isNaN(val), err
+	}},
+	"between": {ordering: true, check: func(val reflect.Value, params []string) (bool, error) {
+		c, err := compareParams(val, params, 2)
+		return err == nil && c[0] >= 0 && c[1] <= 0, err
+	}},
+	"oneof": {check: func(val reflect.Value, params []string) (bool, error) {
+		c, err := compareParams(val, params, -1)
+		return err == nil && slices.Contains(c, 0), err
+	}},
+	"len": {collection: true, check: func(val reflect.Value, params []string) (bool, error) {
+		n, ok := valueLen(val)
+		if !ok {
+			return false, fmt.Errorf("cannot be applied to %s", val.Type())
+		}
+		if len(params) != 1 && len(params) != 2 {
+			return false, errors.New("expects 1 or 2 parameters")
+		}
+
+		bounds := make([]int, len(params))
+		for i, param := range params {
+			bound, err := strconv.Atoi(param)
+			if err != nil {
+				return false, fmt.Errorf("has invalid parameter %q", param)
+			}
+			bounds[i] = bound
+		}
+
+		return n >= bounds[0] && n <= bounds[len(bounds)-1], nil
+	}},
+	"unique": {collection: true, check: func(val reflect.Value, params []string) (bool, error) {
+		if params != nil {
+			return false, errors.New("expects no parameters")
+		}
+
+		var elems []reflect.Value
+		switch val.Kind() {
+		case reflect.Slice, reflect.Array:
+			for i := 0; i < val.Len(); i++ {
+				elems = append(elems, val.Index(i))
+			}
+		case reflect.Map:
+			iter := val.MapRange()
+			for iter.Next() {
+				elems = append(elems, iter.Value())
+			}
+		default:
+			return false, fmt.Errorf("cannot be applied to %s", val.Type())
+		}
+		if !val.Type().Elem().Comparable() || !val.CanInterface() {
+			return false, fmt.Errorf("cannot be applied to %s", val.Type())
+		}
+
+		seen := make(map[interface{}]bool, len(elems))
+		for _, elem := range elems {
+			// Interfaces may hold values of uncomparable types such as slices.
+			if !elem.Comparable() {
+				return false, fmt.Errorf("cannot be applied to %s holding uncomparable values", val.Type())
+			}
+			if seen[elem.Interface()] {
+				return false, nil
+			}
+			seen[elem.Interface()] = true
+		}
+
+		return true, nil
+	}},
+}
+
+// compareParams compares val with each of params parsed as a value of its
+// type. It expects n params, or at least one if n is negative.
+func compareParams(val reflect.Value, params []string, n int) ([]int, error) {
+	switch {
+	case n < 0 && len(params) == 0:
+		return nil, errors.New("expects parameters")
+	case n == 1 && len(params) != 1:
+		return nil, errors.New("expects 1 parameter")
+	case n > 1 && len(params) != n:
+		return nil, fmt.Errorf("expects %d parameters", n)
+	}
+
+	c := make([]int, len(params))
+	for i, param := range params {
+		var err error
+		if c[i], err = compareParam(val, param); err != nil {
+			return nil, err
+		}
+	}
+
+	return c, nil
+}
+
+func compareParam(val reflect.Value, param string) (int, error) {
+	invalid := fmt.Errorf("has invalid parameter %q", param)
+	switch val.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		p, err := strconv.ParseInt(param, 10, 64)
+		if err != nil {
+			return 0, invalid
+		}
+		return cmp.Compare(val.Int(), p), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		p, err := strconv.ParseUint(param, 10, 64)
+		if err != nil {
+			return 0, invalid
+		}
+		return cmp.Compare(val.Uint(), p), nil
+	case reflect.Float32, reflect.Float64:
+		p, err := strconv.ParseFloat(param, 64)
+		if err != nil {
+			return 0, invalid
+		}
+		return cmp.Compare(val.Float(), p), nil
+	case reflect.String:
+		return cmp.Compare(val.String(), param), nil
+	case reflect.Struct:
+		if val.Type() == timeType && val.CanInterface() {
+			p, err := time.Parse(time.RFC3339, param)
+			if err != nil {
+				return 0, invalid
+			}
+			return val.Interface().(time.Time).Compare(p), nil
+		}
+	}
+
+	return 0, fmt.Errorf("cannot be applied to %s", val.Type())
+}
+
+func isNaN(val reflect.Value) bool {
+	return (val.Kind() == reflect.Float32 || val.Kind() == reflect.Float64) && math.IsNaN(val.Float())
+}
+
+// valueLen returns the length of a collection or the number of runes of a string.
+func valueLen(val reflect.Value) (int, bool) {
+	switch val.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map, reflect.Chan:
+		return val.Len(), true
+	case reflect.String:
+		return utf8.RuneCountInString(val.String()), true
+	}
+
+	return 0, false
+}
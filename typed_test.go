@@ -0,0 +1,196 @@
+package validator
+
+import (
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestTypedRules(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		name     string
+		actual   bool
+		expected bool
+	}{
+		{"Min(1)(1)", Min(1)(1), true},
+		{"Min(1)(0)", Min(1)(0), false},
+		{"Min(1.5)(NaN)", Min(1.5)(math.NaN()), false},
+		{`Min("b")("a")`, Min("b")("a"), false},
+		{"Max(10)(10)", Max(10)(10), true},
+		{"Max(10)(11)", Max(10)(11), false},
+		{"Max(1.5)(NaN)", Max(1.5)(math.NaN()), false},
+		{"Between(1, 10)(5)", Between(1, 10)(5), true},
+		{"Between(1, 10)(0)", Between(1, 10)(0), false},
+		{"Between(0.0, 1.0)(NaN)", Between(0.0, 1.0)(math.NaN()), false},
+		{"Between(uint8(1), 3)(4)", Between[uint8](1, 3)(4), false},
+		{`OneOf("a", "b")("b")`, OneOf("a", "b")("b"), true},
+		{`OneOf("a", "b")("c")`, OneOf("a", "b")("c"), false},
+		{"OneOf[int]()(0)", OneOf[int]()(0), false},
+		{"Len[[]int](1, 3)([1 2])", Len[[]int](1, 3)([]int{1, 2}), true},
+		{"Len[[]int](1, 3)(nil)", Len[[]int](1, 3)(nil), false},
+		{"Len[map[string]int](1, 1)", Len[map[string]int](1, 1)(map[string]int{"a": 1}), true},
+		{`Len[string](2, 2)("你好")`, Len[string](2, 2)("你好"), true},
+		{"Len[int](0, 1)(0)", Len[int](0, 1)(0), false},
+		{"Unique[int]()([1 2 3])", Unique[int]()([]int{1, 2, 3}), true},
+		{"Unique[int]()([1 2 1])", Unique[int]()([]int{1, 2, 1}), false},
+		{"ForEach(Min(0))([0 1])", ForEach(Min(0))([]int{0, 1}), true},
+		{"ForEach(Min(0))([0 -1])", ForEach(Min(0))([]int{0, -1}), false},
+		{"ForEach(Min(0))(nil)", ForEach(Min(0))(nil), true},
+	}
+	for _, test := range tests {
+		if test.actual != test.expected {
+			t.Errorf("Expected %s to be %v, got %v", test.name, test.expected, test.actual)
+		}
+	}
+}
+
+func TestValidateStructTypedRules(t *testing.T) {
+	t.Parallel()
+
+	type product struct {
+		Quantity int               `valid:"required,min(1),max(100)"`
+		Price    float64           `valid:"between(0.01|9999.99)"`
+		Stock    uint              `valid:"max(1000)"`
+		Size     string            `valid:"oneof(S|M|L)"`
+		Code     string            `valid:"len(4),alphanum"`
+		Tags     []string          `valid:"len(1|3),unique,alpha"`
+		Ratings  []int             `valid:"unique,between(1|5)"`
+		Prices   map[string]int    `valid:"len(1|2),min(0)"`
+		Released time.Time         `valid:"min(2000-01-01T00:00:00Z)"`
+		Discount *float64          `valid:"max(0.5)"`
+		Options  map[string]string `valid:"alpha"`
+	}
+
+	discount := 0.2
+	valid := product{
+		Quantity: 10,
+		Price:    9.99,
+		Size:     "M",
+		Code:     "AB12",
+		Tags:     []string{"new", "sale"},
+		Ratings:  []int{4, 5},
+		Prices:   map[string]int{"EUR": 10},
+		Released: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+		Discount: &discount,
+		Options:  map[string]string{"color": "red"},
+	}
+	if err := ValidateStruct(valid); err != nil {
+		t.Errorf("Expected the product to be valid, got %v", err)
+	}
+
+	discount = math.NaN()
+	invalid := product{
+		Quantity: 101,
+		Price:    0,
+		Stock:    1001,
+		Size:     "XL",
+		Code:     "ABC",
+		Tags:     []string{"a", "b", "a", "d1"},
+		Ratings:  []int{0, 5, 5},
+		Prices:   map[string]int{"USD": -1, "EUR": 1, "GBP": 2},
+		Released: time.Date(1999, 12, 31, 0, 0, 0, 0, time.UTC),
+		Discount: &discount,
+		Options:  map[string]string{"size": "X1", "color": "red"},
+	}
+	expected := []string{
+		"validator: Quantity does not validate as max(100)",
+		"validator: Price does not validate as between(0.01|9999.99)",
+		"validator: Stock does not validate as max(1000)",
+		"validator: Size does not validate as oneof(S|M|L)",
+		"validator: Code does not validate as len(4)",
+		"validator: Tags does not validate as len(1|3)",
+		"validator: Tags does not validate as unique",
+		"validator: Tags[3] does not validate as alpha",
+		"validator: Ratings does not validate as unique",
+		"validator: Ratings[0] does not validate as between(1|5)",
+		"validator: Prices does not validate as len(1|2)",
+		"validator: Prices[USD] does not validate as min(0)",
+		"validator: Released does not validate as min(2000-01-01T00:00:00Z)",
+		"validator: Discount does not validate as max(0.5)",
+		"validator: Options[size] does not validate as alpha",
+	}
+	if actual := errorMessages(ValidateStruct(invalid)); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("Expected ValidateStruct to fail with\n%q, got\n%q", expected, actual)
+	}
+
+	if actual := errorMessages(ValidateStruct(product{})); !reflect.DeepEqual(actual, []string{
+		"validator: Quantity is required",
+		"validator: Price does not validate as between(0.01|9999.99)",
+		"validator: Tags does not validate as len(1|3)",
+		"validator: Prices does not validate as len(1|2)",
+		"validator: Released does not validate as min(2000-01-01T00:00:00Z)",
+	}) {
+		t.Errorf("Expected the zero product to fail, got %q", actual)
+	}
+}
+
+func TestValidateStructTypedRulesInvalidUsage(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    interface{}
+		expected string
+	}{
+		{struct {
+			Age int `valid:"min(a)"`
+		}{}, `Age: rule "min" has invalid parameter "a"`},
+		{struct {
+			Age int `valid:"min(1|2)"`
+		}{}, `Age: rule "min" expects 1 parameter`},
+		{struct {
+			Age int `valid:"between(1)"`
+		}{}, `Age: rule "between" expects 2 parameters`},
+		{struct {
+			Age int `valid:"oneof"`
+		}{}, `Age: rule "oneof" expects parameters`},
+		{struct {
+			Age uint `valid:"min(-1)"`
+		}{}, `Age: rule "min" has invalid parameter "-1"`},
+		{struct {
+			Age int `valid:"len(1|2)"`
+		}{}, `Age: rule "len" cannot be applied to int`},
+		{struct {
+			Age int `valid:"unique"`
+		}{}, `Age: rule "unique" cannot be applied to int`},
+		{struct {
+			Tags []string `valid:"unique(1)"`
+		}{Tags: []string{"a"}}, `Tags: rule "unique" expects no parameters`},
+		{struct {
+			Tags [][]string `valid:"unique"`
+		}{Tags: [][]string{{"a"}}}, `Tags: rule "unique" cannot be applied to [][]string`},
+		{struct {
+			Tags []interface{} `valid:"unique"`
+		}{Tags: []interface{}{[]int{1}, 2}}, `Tags: rule "unique" cannot be applied to []interface {} holding uncomparable values`},
+		{struct {
+			Tags map[string]interface{} `valid:"unique"`
+		}{Tags: map[string]interface{}{"a": map[string]int{}}}, `Tags: rule "unique" cannot be applied to map[string]interface {} holding uncomparable values`},
+		{struct {
+			Age string `valid:"numeric,min(18)"`
+		}{Age: "100"}, `Age: rule "min" cannot be applied to string, see range for numeric strings`},
+		{struct {
+			Name string `valid:"between(a|z)"`
+		}{}, `Name: rule "between" cannot be applied to string`},
+		{struct {
+			Names []string `valid:"max(z)"`
+		}{Names: []string{"a"}}, `Names[0]: rule "max" cannot be applied to string`},
+		{struct {
+			Enabled bool `valid:"min(1)"`
+		}{}, `Enabled: rule "min" cannot be applied to bool`},
+		{struct {
+			At time.Time `valid:"max(yesterday)"`
+		}{}, `At: rule "max" has invalid parameter "yesterday"`},
+		{struct {
+			Age int `valid:"alpha"`
+		}{}, `Age: rule "alpha" cannot be applied to int`},
+	}
+	for _, test := range tests {
+		err := ValidateStruct(test.param)
+		if err == nil || !strings.Contains(err.Error(), test.expected) {
+			t.Errorf("Expected ValidateStruct(%#v) to fail with %q, got %v", test.param, test.expected, err)
+		}
+	}
+}
@@ -0,0 +1,329 @@
+package validator
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// uriTemplateOp holds the expansion behaviour of one RFC 6570 operator,
+// as listed in Appendix A of the RFC.
+type uriTemplateOp struct {
+	first    string
+	sep      string
+	named    bool
+	ifEmpty  string
+	reserved bool
+}
+
+var uriTemplateOps = map[byte]uriTemplateOp{
+	0:   {first: "", sep: ","},
+	'+': {first: "", sep: ",", reserved: true},
+	'#': {first: "#", sep: ",", reserved: true},
+	'.': {first: ".", sep: "."},
+	'/': {first: "/", sep: "/"},
+	';': {first: ";", sep: ";", named: true},
+	'?': {first: "?", sep: "&", named: true, ifEmpty: "="},
+	'&': {first: "&", sep: "&", named: true, ifEmpty: "="},
+}
+
+type uriTemplateVar struct {
+	name    string
+	prefix  int
+	explode bool
+}
+
+type uriTemplatePart struct {
+	literal string
+	op      byte
+	vars    []uriTemplateVar
+}
+
+// IsURITemplate checks if the string is a valid URI template of any level
+// defined by RFC 6570.
+func IsURITemplate(str string) bool {
+	_, err := parseURITemplate(str)
+	return err == nil
+}
+
+// ExpandURITemplate expands the RFC 6570 template tmpl with vars and checks
+// the result with IsRequestURI. Values may be strings, []string,
+// map[string]string (expanded in key order) or any other value formatted with
+// fmt.Sprint; nil values, empty lists and empty maps are undefined.
+func ExpandURITemplate(tmpl string, vars map[string]interface{}) (string, error) {
+	parts, err := parseURITemplate(tmpl)
+	if err != nil {
+		return "", err
+	}
+
+	var b strings.Builder
+	for _, part := range parts {
+		if part.vars == nil {
+			b.WriteString(part.literal)
+			continue
+		}
+		if err := expandURITemplateExpr(&b, part, vars); err != nil {
+			return "", err
+		}
+	}
+
+	uri := b.String()
+	if !IsRequestURI(uri) {
+		return "", fmt.Errorf("validator: URI template %q expands to invalid request URI %q", tmpl, uri)
+	}
+
+	return uri, nil
+}
+
+func parseURITemplate(tmpl string) ([]uriTemplatePart, error) {
+	var parts []uriTemplatePart
+	for tmpl != "" {
+		i := strings.IndexAny(tmpl, "{}")
+		if i < 0 {
+			i = len(tmpl)
+		}
+		if i > 0 {
+			literal, err := encodeURITemplateLiteral(tmpl[:i])
+			if err != nil {
+				return nil, err
+			}
+			parts = append(parts, uriTemplatePart{literal: literal})
+			tmpl = tmpl[i:]
+			continue
+		}
+		if tmpl[0] == '}' {
+			return nil, fmt.Errorf("validator: unexpected '}' in URI template")
+		}
+
+		end := strings.IndexByte(tmpl, '}')
+		if end < 0 {
+			return nil, fmt.Errorf("validator: unterminated expression in URI template")
+		}
+		part, err := parseURITemplateExpr(tmpl[1:end])
+		if err != nil {
+			return nil, err
+		}
+		parts = append(parts, part)
+		tmpl = tmpl[end+1:]
+	}
+
+	return parts, nil
+}
+
+func parseURITemplateExpr(expr string) (uriTemplatePart, error) {
+	part := uriTemplatePart{}
+	if expr != "" {
+		if _, ok := uriTemplateOps[expr[0]]; ok && expr[0] != 0 {
+			part.op, expr = expr[0], expr[1:]
+		} else if strings.IndexByte("=,!@|", expr[0]) >= 0 {
+			return part, fmt.Errorf("validator: reserved operator %q in URI template", expr[0])
+		}
+	}
+
+	for _, spec := range strings.Split(expr, ",") {
+		v := uriTemplateVar{}
+		if strings.HasSuffix(spec, "*") {
+			v.explode, spec = true, spec[:len(spec)-1]
+		} else if i := strings.IndexByte(spec, ':'); i >= 0 {
+			length := spec[i+1:]
+			n, err := strconv.Atoi(length)
+			if err != nil || len(length) > 4 || length[0] == '0' {
+				return part, fmt.Errorf("validator: invalid prefix modifier %q in URI template", spec)
+			}
+			v.prefix, spec = n, spec[:i]
+		}
+		if !isURITemplateVarName(spec) {
+			return part, fmt.Errorf("validator: invalid variable name %q in URI template", spec)
+		}
+		v.name = spec
+		part.vars = append(part.vars, v)
+	}
+
+	return part, nil
+}
+
+// isURITemplateVarName checks varname = varchar *( ["."] varchar ),
+// with varchar = ALPHA / DIGIT / "_" / pct-encoded.
+func isURITemplateVarName(name string) bool {
+	if name == "" || name[0] == '.' || name[len(name)-1] == '.' {
+		return false
+	}
+
+	for i := 0; i < len(name); i++ {
+		c := name[i]
+		switch {
+		case c == '%':
+			if i+2 >= len(name) || !isHex(name[i+1]) || !isHex(name[i+2]) {
+				return false
+			}
+			i += 2
+		case c == '.':
+			if name[i-1] == '.' {
+				return false
+			}
+		case c != '_' && !('a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9'):
+			return false
+		}
+	}
+
+	return true
+}
+
+// encodeURITemplateLiteral checks the literal characters of a template and
+// percent-encodes non-ASCII characters.
+func encodeURITemplateLiteral(literal string) (string, error) {
+	var b strings.Builder
+	for i := 0; i < len(literal); i++ {
+		c := literal[i]
+		switch {
+		case c == '%':
+			if i+2 >= len(literal) || !isHex(literal[i+1]) || !isHex(literal[i+2]) {
+				return "", fmt.Errorf("validator: invalid percent-encoding in URI template")
+			}
+			b.WriteString(literal[i : i+3])
+			i += 2
+		case c >= utf8.RuneSelf:
+			b.WriteString(pctEncode(c))
+		case c <= ' ' || c == 0x7f || strings.IndexByte("\"'<>\\^`|", c) >= 0:
+			return "", fmt.Errorf("validator: invalid character %q in URI template", c)
+		default:
+			b.WriteByte(c)
+		}
+	}
+
+	return b.String(), nil
+}
+
+func expandURITemplateExpr(b *strings.Builder, part uriTemplatePart, vars map[string]interface{}) error {
+	op := uriTemplateOps[part.op]
+	first := true
+	for _, v := range part.vars {
+		var items []string
+		var pairs [][2]string
+		isComposite := true
+
+		switch value := vars[v.name].(type) {
+		case nil:
+			continue
+		case []string:
+			items = value
+		case map[string]string:
+			keys := make([]string, 0, len(value))
+			for k := range value {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+			for _, k := range keys {
+				pairs = append(pairs, [2]string{k, value[k]})
+			}
+		case string:
+			items, isComposite = []string{value}, false
+		default:
+			items, isComposite = []string{fmt.Sprint(value)}, false
+		}
+		if len(items) == 0 && len(pairs) == 0 {
+			continue
+		}
+		if isComposite && v.prefix > 0 {
+			return fmt.Errorf("validator: prefix modifier applied to composite variable %q", v.name)
+		}
+
+		if first {
+			b.WriteString(op.first)
+			first = false
+		} else {
+			b.WriteString(op.sep)
+		}
+
+		if !isComposite {
+			value := items[0]
+			if v.prefix > 0 && utf8.RuneCountInString(value) > v.prefix {
+				value = string([]rune(value)[:v.prefix])
+			}
+			writeURITemplateNamed(b, op, v.name, value)
+			continue
+		}
+
+		if !v.explode {
+			if op.named {
+				b.WriteString(v.name + "=")
+			}
+			encoded := make([]string, 0, len(items)+2*len(pairs))
+			for _, item := range items {
+				encoded = append(encoded, encodeURITemplateValue(item, op.reserved))
+			}
+			for _, pair := range pairs {
+				encoded = append(encoded, encodeURITemplateValue(pair[0], op.reserved), encodeURITemplateValue(pair[1], op.reserved))
+			}
+			b.WriteString(strings.Join(encoded, ","))
+			continue
+		}
+
+		for i, item := range items {
+			if i > 0 {
+				b.WriteString(op.sep)
+			}
+			if op.named {
+				writeURITemplateNamed(b, op, v.name, item)
+			} else {
+				b.WriteString(encodeURITemplateValue(item, op.reserved))
+			}
+		}
+		for i, pair := range pairs {
+			if i > 0 {
+				b.WriteString(op.sep)
+			}
+			if op.named {
+				writeURITemplateNamed(b, op, pair[0], pair[1])
+			} else {
+				b.WriteString(encodeURITemplateValue(pair[0], op.reserved) + "=" + encodeURITemplateValue(pair[1], op.reserved))
+			}
+		}
+	}
+
+	return nil
+}
+
+func writeURITemplateNamed(b *strings.Builder, op uriTemplateOp, name, value string) {
+	if !op.named {
+		b.WriteString(encodeURITemplateValue(value, op.reserved))
+		return
+	}
+
+	b.WriteString(encodeURITemplateValue(name, op.reserved))
+	if value == "" {
+		b.WriteString(op.ifEmpty)
+		return
+	}
+	b.WriteString("=" + encodeURITemplateValue(value, op.reserved))
+}
+
+// encodeURITemplateValue percent-encodes every character that is not
+// unreserved, also keeping reserved characters and existing pct-encoded
+// triplets if reserved is set.
+func encodeURITemplateValue(value string, reserved bool) string {
+	var b strings.Builder
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		switch {
+		case isUnreserved(c):
+			b.WriteByte(c)
+		case reserved && strings.IndexByte(":/?#[]@!$&'()*+,;=", c) >= 0:
+			b.WriteByte(c)
+		case reserved && c == '%' && i+2 < len(value) && isHex(value[i+1]) && isHex(value[i+2]):
+			b.WriteString(value[i : i+3])
+			i += 2
+		default:
+			b.WriteString(pctEncode(c))
+		}
+	}
+
+	return b.String()
+}
+
+func pctEncode(c byte) string {
+	const hex = "0123456789ABCDEF"
+	return string([]byte{'%', hex[c>>4], hex[c&0x0f]})
+}
@@ -0,0 +1,117 @@
+package validator
+
+import "testing"
+
+func TestIsURITemplate(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"{", false},
+		{"}", false},
+		{"/users/{}", false},
+		{"/users/{id", false},
+		{"/users/id}", false},
+		{"/users/{=id}", false},
+		{"/users/{|id}", false},
+		{"/users/{id.}", false},
+		{"/users/{i..d}", false},
+		{"/users/{i-d}", false},
+		{"/users/{id:0}", false},
+		{"/users/{id:10000}", false},
+		{"/users/{id:x}", false},
+		{"/users/{id,}", false},
+		{"/users/{id}/{{name}}", false},
+		{"/a b/{id}", false},
+		{"/a<b>/{id}", false},
+		{"/a%zz/{id}", false},
+
+		{"", true},
+		{"/users", true},
+		{"/users/{id}", true},
+		{"/users/{id}/posts{?page,limit}", true},
+		{"{+path}/here", true},
+		{"{#section}", true},
+		{"{.format}", true},
+		{"{/segments*}", true},
+		{"{;params*}", true},
+		{"{?query*}{&more}", true},
+		{"{var:3}", true},
+		{"{a.b_c%20d}", true},
+		{"/städte/{id}", true},
+	}
+	for _, test := range tests {
+		actual := IsURITemplate(test.param)
+		if actual != test.expected {
+			t.Errorf("Expected IsURITemplate(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestExpandURITemplate(t *testing.T) {
+	t.Parallel()
+
+	// Examples from RFC 6570 section 3.2.
+	vars := map[string]interface{}{
+		"count": []string{"one", "two", "three"},
+		"dom":   []string{"example", "com"},
+		"dub":   "me/too",
+		"hello": "Hello World!",
+		"half":  "50%",
+		"var":   "value",
+		"who":   "fred",
+		"base":  "http://example.com/home/",
+		"path":  "/foo/bar",
+		"list":  []string{"red", "green", "blue"},
+		"keys":  map[string]string{"semi": ";", "dot": ".", "comma": ","},
+		"v":     "6",
+		"x":     "1024",
+		"y":     "768",
+		"empty": "",
+		"id":    42,
+		"undef": nil,
+		"none":  []string{},
+	}
+
+	var tests = []struct {
+		param    string
+		expected string
+	}{
+		{"/{var}", "/value"},
+		{"/{hello}", "/Hello%20World%21"},
+		{"/{half}", "/50%25"},
+		{"/users/{id}", "/users/42"},
+		{"{+path}/here", "/foo/bar/here"},
+		{"/here?ref={+path}", "/here?ref=/foo/bar"},
+		{"{/var,x}/here", "/value/1024/here"},
+		{"{/list*,path:4}", "/red/green/blue/%2Ffoo"},
+		{"/map?{x,y}", "/map?1024,768"},
+		{"/x{#path:6}/here", "/x#/foo/b/here"},
+		{"/x{.dom*}", "/x.example.com"},
+		{"/p{;x,y,empty}", "/p;x=1024;y=768;empty"},
+		{"/p{;keys*}", "/p;comma=%2C;dot=.;semi=%3B"},
+		{"/p{?x,y,empty}", "/p?x=1024&y=768&empty="},
+		{"/p{?list}", "/p?list=red,green,blue"},
+		{"/p{?list*}", "/p?list=red&list=green&list=blue"},
+		{"/p{?keys}", "/p?keys=comma,%2C,dot,.,semi,%3B"},
+		{"/p?fixed=yes{&x}", "/p?fixed=yes&x=1024"},
+		{"/p{?undef,none,var}", "/p?var=value"},
+		{"/{count*}", "/one,two,three"},
+		{"/{var:3}", "/val"},
+		{"/städte", "/st%C3%A4dte"},
+	}
+	for _, test := range tests {
+		actual, err := ExpandURITemplate(test.param, vars)
+		if err != nil || actual != test.expected {
+			t.Errorf("Expected ExpandURITemplate(%q) to be %q, got %q (%v)", test.param, test.expected, actual, err)
+		}
+	}
+
+	for _, param := range []string{"{id", "{list:2}", "{var}", "{?x}"} {
+		if actual, err := ExpandURITemplate(param, vars); err == nil {
+			t.Errorf("Expected ExpandURITemplate(%q) to fail, got %q", param, actual)
+		}
+	}
+}
@@ -0,0 +1,360 @@
+package validator
+
+import (
+	"net"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// URLOptions describes the policy applied by IsURLWith. The zero value
+// accepts exactly what IsURL accepts; every field only narrows it further.
+type URLOptions struct {
+	// RequireScheme rejects URLs without an explicit "scheme://" prefix.
+	RequireScheme bool
+
+	// Schemes restricts explicit schemes to this list (case-insensitive).
+	// Combine with RequireScheme to also reject scheme-less URLs.
+	Schemes []string
+
+	// RequireTLD rejects hosts without a top-level domain, such as
+	// "localhost" or IP addresses.
+	RequireTLD bool
+
+	// ForbidUserinfo rejects URLs carrying "user:pass@" credentials.
+	ForbidUserinfo bool
+
+	// ForbidIPHost rejects URLs whose host is an IPv4 or IPv6 literal.
+	ForbidIPHost bool
+
+	// ForbidMixedScriptHost rejects hosts with a label mixing scripts, such
+	// as "pаypal.com" with a Cyrillic "а". Punycode labels are decoded first.
+	ForbidMixedScriptHost bool
+
+	// AllowedPorts, when not empty, is the list of accepted ports.
+	// DeniedPorts is the list of rejected ports. A URL without an explicit
+	// port is checked against the default port of its scheme; if the scheme
+	// has no known default, such as tcp or udp, the URL is rejected when
+	// AllowedPorts is set and DeniedPorts is not consulted.
+	AllowedPorts []int
+	DeniedPorts  []int
+
+	// IgnoreLengthLimit disables the maxURLRuneCount limit.
+	IgnoreLengthLimit bool
+}
+
+// defaultURLPorts maps the schemes accepted by URLSchema to their well-known ports.
+var defaultURLPorts = map[string]int{
+	"ftp":   21,
+	"http":  80,
+	"https": 443,
+	"ws":    80,
+	"wss":   443,
+}
+
+// URLRule identifies the check that rejected an URL.
+type URLRule string
+
+// Rules reported by ValidateURL and ValidateURLWith.
+const (
+	URLRuleEmpty       URLRule = "empty"
+	URLRuleLength      URLRule = "length"
+	URLRuleLeadingDot  URLRule = "leading_dot"
+	URLRuleParse       URLRule = "parse"
+	URLRuleHostDot     URLRule = "host_dot"
+	URLRuleMissingHost URLRule = "missing_host"
+	URLRulePattern     URLRule = "pattern"
+
+	// Rules enforced by URLOptions.
+	URLRuleScheme      URLRule = "scheme"
+	URLRuleUserinfo    URLRule = "userinfo"
+	URLRuleIPHost      URLRule = "ip_host"
+	URLRuleTLD         URLRule = "tld"
+	URLRuleMixedScript URLRule = "mixed_script"
+	URLRulePort        URLRule = "port"
+)
+
+var urlRuleMessages = map[URLRule]string{
+	URLRuleEmpty:       "is empty",
+	URLRuleLength:      "has an invalid length",
+	URLRuleLeadingDot:  "starts with a dot",
+	URLRuleParse:       "cannot be parsed",
+	URLRuleHostDot:     "has a host starting with a dot",
+	URLRuleMissingHost: "has no host",
+	URLRulePattern:     "does not match the URL pattern",
+	URLRuleScheme:      "has a missing or disallowed scheme",
+	URLRuleUserinfo:    "must not contain user information",
+	URLRuleIPHost:      "must not use an IP address as host",
+	URLRuleTLD:         "has no top-level domain",
+	URLRuleMixedScript: "has a host mixing scripts",
+	URLRulePort:        "uses a disallowed port",
+}
+
+// URLComponent names the part of an URL that failed URLRulePattern.
+type URLComponent string
+
+// Components reported in URLError.Component.
+const (
+	URLComponentScheme   URLComponent = "scheme"
+	URLComponentUserinfo URLComponent = "userinfo"
+	URLComponentHost     URLComponent = "host"
+	URLComponentPort     URLComponent = "port"
+	URLComponentPath     URLComponent = "path"
+)
+
+// URLError describes why a string was rejected as an URL.
+type URLError struct {
+	URL  string
+	Rule URLRule
+	// Component is set for URLRulePattern when the failing part could be
+	// identified.
+	Component URLComponent
+	// Err is the underlying error for URLRuleParse.
+	Err error
+}
+
+func (e *URLError) Error() string {
+	msg := "validator: url " + strconv.Quote(e.URL) + " " + urlRuleMessages[e.Rule]
+	if e.Component != "" {
+		msg += " (invalid " + string(e.Component) + ")"
+	}
+	if e.Err != nil {
+		msg += ": " + e.Err.Error()
+	}
+
+	return msg
+}
+
+func (e *URLError) Unwrap() error {
+	return e.Err
+}
+
+// IsURLWith checks if the string is an URL satisfying the given options.
+func IsURLWith(str string, opts URLOptions) bool {
+	return ValidateURLWith(str, opts) == nil
+}
+
+// ValidateURL returns a *URLError describing why IsURL rejects the string,
+// or nil if it is an URL.
+func ValidateURL(str string) error {
+	return ValidateURLWith(str, URLOptions{})
+}
+
+// ValidateURLWith returns a *URLError describing why IsURLWith rejects the
+// string with the given options, or nil if it is accepted.
+func ValidateURLWith(str string, opts URLOptions) error {
+	if err := validateURL(str, opts); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func validateURL(str string, opts URLOptions) *URLError {
+	if str == "" {
+		return &URLError{URL: str, Rule: URLRuleEmpty}
+	}
+	if len(str) <= minURLRuneCount || (!opts.IgnoreLengthLimit && utf8.RuneCountInString(str) >= maxURLRuneCount) {
+		return &URLError{URL: str, Rule: URLRuleLength}
+	}
+	if strings.HasPrefix(str, ".") {
+		return &URLError{URL: str, Rule: URLRuleLeadingDot}
+	}
+
+	strTemp := str
+	if strings.Contains(str, ":") && !strings.Contains(str, "://") {
+		// support no indicated urlscheme but with colon for port number
+		// http:// is appended so url.Parse will succeed, strTemp used so it does not impact rxURL.MatchString
+		strTemp = "http://" + str
+	}
+
+	u, err := url.Parse(strTemp)
+	if err != nil {
+		return &URLError{URL: str, Rule: URLRuleParse, Err: err}
+	}
+	if strings.HasPrefix(u.Host, ".") {
+		return &URLError{URL: str, Rule: URLRuleHostDot}
+	}
+	if u.Host == "" && (u.Path != "" && !strings.Contains(u.Path, ".")) {
+		return &URLError{URL: str, Rule: URLRuleMissingHost}
+	}
+	if !urlRegexp.MatchString(str) {
+		return &URLError{URL: str, Rule: URLRulePattern, Component: failingURLComponent(str)}
+	}
+
+	if rule := checkURLPolicy(str, opts); rule != "" {
+		return &URLError{URL: str, Rule: rule}
+	}
+
+	return nil
+}
+
+// failingURLComponent splits str into the parts matched by URL and returns
+// the first one that does not match its own pattern.
+func failingURLComponent(str string) URLComponent {
+	rest := str
+	if i := strings.Index(rest, "://"); i >= 0 && rxScheme.MatchString(rest[:i]) {
+		if !rxURLSchema.MatchString(rest[:i+3]) {
+			return URLComponentScheme
+		}
+		rest = rest[i+3:]
+	}
+
+	authority, path := rest, ""
+	if i := strings.IndexAny(rest, "/?#"); i >= 0 {
+		authority, path = rest[:i], rest[i:]
+	}
+	if i := strings.LastIndex(authority, "@"); i >= 0 {
+		if !rxURLUsername.MatchString(authority[:i+1]) {
+			return URLComponentUserinfo
+		}
+		authority = authority[i+1:]
+	}
+
+	host, port := authority, ""
+	start := strings.LastIndex(authority, "]") + 1
+	if i := strings.Index(authority[start:], ":"); i >= 0 {
+		host, port = authority[:start+i], authority[start+i:]
+	}
+	if !rxURLHost.MatchString(host) {
+		return URLComponentHost
+	}
+	if port != "" && !rxURLPort.MatchString(port) {
+		return URLComponentPort
+	}
+	if path != "" && !rxURLPath.MatchString(path) {
+		return URLComponentPath
+	}
+
+	return ""
+}
+
+// urlScheme returns the lowercased scheme of str, or "" if str does not start
+// with "scheme://". A "://" inside the path or query is not a scheme.
+func urlScheme(str string) string {
+	i := strings.Index(str, "://")
+	if i < 0 || !rxScheme.MatchString(str[:i]) {
+		return ""
+	}
+
+	return strings.ToLower(str[:i])
+}
+
+// checkURLPolicy applies the restrictions of opts to a string already
+// accepted by IsURL and returns the violated rule, if any.
+func checkURLPolicy(str string, opts URLOptions) URLRule {
+	scheme, target := urlScheme(str), "http://"+str
+	if scheme != "" {
+		target = str
+	}
+
+	if scheme == "" && opts.RequireScheme {
+		return URLRuleScheme
+	}
+	if scheme != "" && len(opts.Schemes) > 0 && !containsFold(opts.Schemes, scheme) {
+		return URLRuleScheme
+	}
+
+	u, err := url.Parse(target)
+	if err != nil {
+		return URLRuleParse
+	}
+	if opts.ForbidUserinfo && u.User != nil {
+		return URLRuleUserinfo
+	}
+
+	host := u.Hostname()
+	isIP := net.ParseIP(host) != nil
+	if opts.ForbidIPHost && isIP {
+		return URLRuleIPHost
+	}
+	if opts.RequireTLD && (isIP || !hasTLD(host)) {
+		return URLRuleTLD
+	}
+	if opts.ForbidMixedScriptHost && !isIP && !isSingleScriptHost(host) {
+		return URLRuleMixedScript
+	}
+
+	if len(opts.AllowedPorts) == 0 && len(opts.DeniedPorts) == 0 {
+		return ""
+	}
+	port, ok := defaultURLPorts[scheme]
+	if scheme == "" {
+		port, ok = defaultURLPorts["http"]
+	}
+	if p := u.Port(); p != "" {
+		if port, err = strconv.Atoi(p); err != nil {
+			return URLRulePort
+		}
+		ok = true
+	}
+	if !ok {
+		if len(opts.AllowedPorts) > 0 {
+			return URLRulePort
+		}
+		return ""
+	}
+	if len(opts.AllowedPorts) > 0 && !slices.Contains(opts.AllowedPorts, port) {
+		return URLRulePort
+	}
+	if slices.Contains(opts.DeniedPorts, port) {
+		return URLRulePort
+	}
+
+	return ""
+}
+
+// isSingleScriptHost reports whether every label of host is written in a
+// single script.
+func isSingleScriptHost(host string) bool {
+	host, err := toUnicodeHost(host)
+	if err != nil {
+		return false
+	}
+
+	for _, label := range strings.Split(host, ".") {
+		if !IsSingleScript(label) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// hasTLD reports whether host ends in a label that can be a top-level
+// domain: at least two letters, or an IDNA "xn--" label.
+func hasTLD(host string) bool {
+	host = strings.TrimSuffix(host, ".")
+	i := strings.LastIndex(host, ".")
+	if i <= 0 {
+		return false
+	}
+
+	tld := strings.ToLower(host[i+1:])
+	if strings.HasPrefix(tld, "xn--") && len(tld) > 4 {
+		return true
+	}
+	if utf8.RuneCountInString(tld) < 2 {
+		return false
+	}
+	for _, c := range tld {
+		if !unicode.IsLetter(c) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func containsFold(list []string, str string) bool {
+	for _, s := range list {
+		if strings.EqualFold(s, str) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -3,15 +3,56 @@ package validator
 import (
 	"net"
 	"net/url"
+	"regexp"
+	"slices"
+	"strconv"
 	"strings"
+	"sync/atomic"
 	"unicode"
 	"unicode/utf8"
 )
 
+var emptyStringInvalid atomic.Bool
+
+// SetEmptyStringValid controls how validators documented with "Empty string
+// is valid" treat the empty string. Passing false makes all of them reject it,
+// so forgetting an IsNotNull check no longer lets empty input through.
+// The default is true.
+func SetEmptyStringValid(valid bool) {
+	emptyStringInvalid.Store(!valid)
+}
+
+func emptyStringValid() bool {
+	return !emptyStringInvalid.Load()
+}
+
+// Strict wraps validator so that it rejects the empty string regardless of
+// SetEmptyStringValid.
+func Strict(validator func(str string) bool) func(str string) bool {
+	return func(str string) bool {
+		return IsNotNull(str) && validator(str)
+	}
+}
+
+// ByteLength checks if the length of the string in bytes is between min and
+// max, both inclusive and given in base 10.
+func ByteLength(str string, min, max string) bool {
+	return inLengthRange(len(str), min, max)
+}
+
+// InRange checks if value is between left and right, both inclusive.
+func InRange(value, left, right float64) bool {
+	if left > right {
+		left, right = right, left
+	}
+
+	return value >= left && value <= right
+}
+
 // IsAlpha checks if the string contains only letters (a-zA-Z). Empty string is valid.
 func IsAlpha(str string) bool {
 	if IsNull(str) {
-		return true
+		return emptyStringValid()
 	}
 
 	return alphaRegexp.MatchString(str)
@@ -20,12 +61,21 @@ func IsAlpha(str string) bool {
 // IsAlphanumeric checks if the string contains only letters and numbers. Empty string is valid.
 func IsAlphanumeric(str string) bool {
 	if IsNull(str) {
-		return true
+		return emptyStringValid()
 	}
 
 	return alphanumericRegexp.MatchString(str)
 }
 
+// IsASCII checks if the string contains ASCII chars only. Empty string is valid.
+func IsASCII(str string) bool {
+	if IsNull(str) {
+		return emptyStringValid()
+	}
+
+	return rxASCII.MatchString(str)
+}
+
 // IsEmail checks if the string is an email.
 func IsEmail(str string) bool {
 	return emailRegexp.MatchString(str)
@@ -62,6 +112,38 @@ func IsExistingEmail(email string) bool {
 	return true
 }
 
+// IsFullWidth checks if the string contains any full-width chars. Empty string is valid.
+func IsFullWidth(str string) bool {
+	if IsNull(str) {
+		return emptyStringValid()
+	}
+
+	return rxFullWidth.MatchString(str)
+}
+
+// IsHalfWidth checks if the string contains any half-width chars. Empty string is valid.
+func IsHalfWidth(str string) bool {
+	if IsNull(str) {
+		return emptyStringValid()
+	}
+
+	return rxHalfWidth.MatchString(str)
+}
+
+// IsIn checks if the string is one of params.
+func IsIn(str string, params ...string) bool {
+	return slices.Contains(params, str)
+}
+
+// IsMultibyte checks if the string contains one or more multibyte chars. Empty string is valid.
+func IsMultibyte(str string) bool {
+	if IsNull(str) {
+		return emptyStringValid()
+	}
+
+	return rxMultibyte.MatchString(str)
+}
+
 // IsNull checks if the string is null.
 func IsNull(str string) bool {
 	return len(str) == 0
@@ -74,11 +156,16 @@ func IsNotNull(str string) bool {
 
 // IsNumeric checks if the string contains only numbers. Empty string is valid.
 func IsNumeric(str string) bool {
+	return IsNumericWith(str, NumericOptions{})
+}
+
+// IsPrintableASCII checks if the string contains printable ASCII chars only. Empty string is valid.
+func IsPrintableASCII(str string) bool {
 	if IsNull(str) {
-		return true
+		return emptyStringValid()
 	}
 
-	return numericRegexp.MatchString(str)
+	return rxPrintableASCII.MatchString(str)
 }
 
 const maxURLRuneCount = 2083
@@ -86,29 +173,7 @@ const minURLRuneCount = 3
 
 // IsURL checks if the string is an URL.
 func IsURL(str string) bool {
-	if str == "" || utf8.RuneCountInString(str) >= maxURLRuneCount || len(str) <= minURLRuneCount || strings.HasPrefix(str, ".") {
-		return false
-	}
-
-	strTemp := str
-	if strings.Contains(str, ":") && !strings.Contains(str, "://") {
-		// support no indicated urlscheme but with colon for port number
-		// http:// is appended so url.Parse will succeed, strTemp used so it does not impact rxURL.MatchString
-		strTemp = "http://" + str
-	}
-
-	u, err := url.Parse(strTemp)
-	if err != nil {
-		return false
-	}
-	if strings.HasPrefix(u.Host, ".") {
-		return false
-	}
-	if u.Host == "" && (u.Path != "" && !strings.Contains(u.Path, ".")) {
-		return false
-	}
-
-	return urlRegexp.MatchString(str)
+	return IsURLWith(str, URLOptions{})
 }
 
 // IsRequestURL checks if the string rawurl, assuming
@@ -133,34 +198,17 @@ func IsRequestURI(rawurl string) bool {
 	return err == nil
 }
 
-// IsUTFDigit checks if the string contains only unicode radix-10 decimal digits. Empty string is valid.
+// IsUTFDigit checks if the string contains only unicode radix-10 decimal digits,
+// optionally preceded by a single + or - sign. Empty string is valid.
 func IsUTFDigit(str string) bool {
-	if IsNull(str) {
-		return true
-	}
-
-	if strings.IndexAny(str, "+-") > 0 {
-		return false
-	}
-
-	if len(str) > 1 {
-		str = strings.TrimPrefix(str, "-")
-		str = strings.TrimPrefix(str, "+")
-	}
-	for _, c := range str {
-		if !unicode.IsDigit(c) { // digits && minus sign are ok
-			return false
-		}
-	}
-
-	return true
+	return IsUTFDigitWith(str, NumericOptions{AllowSign: true})
 }
 
 // IsUTFLetter checks if the string contains only unicode letter characters.
 // Similar to IsAlpha but for all languages. Empty string is valid.
 func IsUTFLetter(str string) bool {
 	if IsNull(str) {
-		return true
+		return emptyStringValid()
 	}
 
 	for _, c := range str {
@@ -175,7 +223,7 @@ func IsUTFLetter(str string) bool {
 // IsUTFLetterNumeric checks if the string contains only unicode letters and numbers. Empty string is valid.
 func IsUTFLetterNumeric(str string) bool {
 	if IsNull(str) {
-		return true
+		return emptyStringValid()
 	}
 
 	for _, c := range str {
@@ -188,25 +236,47 @@ func IsUTFLetterNumeric(str string) bool {
 }
 
 // IsUTFNumeric checks if the string contains only unicode numbers of any kind.
-// Numbers can be 0-9 but also Fractions ¾,Roman Ⅸ and Hangzhou 〩. Empty string is valid.
+// Numbers can be 0-9 but also Fractions ¾,Roman Ⅸ and Hangzhou 〩, optionally
+// preceded by a single + or - sign. Empty string is valid.
 func IsUTFNumeric(str string) bool {
+	return IsUTFNumericWith(str, NumericOptions{AllowSign: true})
+}
+
+// IsVariableWidth checks if the string contains a mixture of full and half-width chars. Empty string is valid.
+func IsVariableWidth(str string) bool {
 	if IsNull(str) {
-		return true
+		return emptyStringValid()
 	}
 
-	if strings.IndexAny(str, "+-") > 0 {
+	return rxHalfWidth.MatchString(str) && rxFullWidth.MatchString(str)
+}
+
+// Matches checks if the string matches the regular expression pattern.
+// An invalid pattern matches nothing.
+func Matches(str, pattern string) bool {
+	r, err := regexp.Compile(pattern)
+	if err != nil {
 		return false
 	}
 
-	if len(str) > 1 {
-		str = strings.TrimPrefix(str, "-")
-		str = strings.TrimPrefix(str, "+")
+	return r.MatchString(str)
+}
+
+// RuneLength checks if the length of the string in runes is between min and
+// max, both inclusive and given in base 10.
+func RuneLength(str string, min, max string) bool {
+	return inLengthRange(utf8.RuneCountInString(str), min, max)
+}
+
+func inLengthRange(length int, min, max string) bool {
+	lo, err := strconv.Atoi(min)
+	if err != nil {
+		return false
 	}
-	for _, c := range str {
-		if !unicode.IsNumber(c) { // numbers && minus sign are ok
-			return false
-		}
+	hi, err := strconv.Atoi(max)
+	if err != nil {
+		return false
 	}
 
-	return true
+	return length >= lo && length <= hi
 }
@@ -1,6 +1,116 @@
 package validator
 
-import "testing"
+import (
+	"testing"
+	"unicode"
+)
+
+// TestSetEmptyStringValid is not parallel since it changes package state.
+func TestSetEmptyStringValid(t *testing.T) {
+	var validators = map[string]func(string) bool{
+		"IsAlpha":            IsAlpha,
+		"IsAlphanumeric":     IsAlphanumeric,
+		"IsASCII":            IsASCII,
+		"IsFullWidth":        IsFullWidth,
+		"IsHalfWidth":        IsHalfWidth,
+		"IsMultibyte":        IsMultibyte,
+		"IsNumeric":          IsNumeric,
+		"IsPrintableASCII":   IsPrintableASCII,
+		"IsUTFDigit":         IsUTFDigit,
+		"IsUTFLetter":        IsUTFLetter,
+		"IsUTFLetterNumeric": IsUTFLetterNumeric,
+		"IsUTFNumeric":       IsUTFNumeric,
+		"IsVariableWidth":    IsVariableWidth,
+		"IsHan":              IsHan,
+		"IsSingleScript":     IsSingleScript,
+		"IsScript":           func(str string) bool { return IsScript(str, unicode.Latin) },
+		"IsSafeDisplayText":  func(str string) bool { return IsSafeDisplayText(str, DisplayPolicy{}) },
+	}
+
+	defer SetEmptyStringValid(true)
+	for _, valid := range []bool{false, true} {
+		SetEmptyStringValid(valid)
+		for name, validator := range validators {
+			if actual := validator(""); actual != valid {
+				t.Errorf("Expected %s(\"\") to be %v with SetEmptyStringValid(%v), got %v", name, valid, valid, actual)
+			}
+		}
+		if IsMixedScript("") {
+			t.Errorf("Expected IsMixedScript(\"\") to be false with SetEmptyStringValid(%v)", valid)
+		}
+	}
+}
+
+func TestStrict(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"", false},
+		{"123", false},
+
+		{"abc", true},
+	}
+	validator := Strict(IsAlpha)
+	for _, test := range tests {
+		actual := validator(test.param)
+		if actual != test.expected {
+			t.Errorf("Expected Strict(IsAlpha)(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestByteLength(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		min      string
+		max      string
+		expected bool
+	}{
+		{"123456", "0", "100", true},
+		{"1239999", "0", "0", false},
+		{"1239asdfasf99", "100", "200", false},
+		{"1239999asdff29", "10", "30", true},
+		{"你", "0", "1", false},
+		{"你", "3", "3", true},
+		{"abc", "a", "3", false},
+		{"abc", "0", "", false},
+	}
+	for _, test := range tests {
+		actual := ByteLength(test.param, test.min, test.max)
+		if actual != test.expected {
+			t.Errorf("Expected ByteLength(%q, %q, %q) to be %v, got %v", test.param, test.min, test.max, test.expected, actual)
+		}
+	}
+}
+
+func TestInRange(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		value    float64
+		left     float64
+		right    float64
+		expected bool
+	}{
+		{0, 0, 0, true},
+		{1, 0, 1, true},
+		{-1, -1, 1, true},
+		{0.5, 1, 0, true},
+		{1.01, 0, 1, false},
+		{-5, 0, 100, false},
+	}
+	for _, test := range tests {
+		actual := InRange(test.value, test.left, test.right)
+		if actual != test.expected {
+			t.Errorf("Expected InRange(%v, %v, %v) to be %v, got %v", test.value, test.left, test.right, test.expected, actual)
+		}
+	}
+}
 
 func TestIsAlpha(t *testing.T) {
 	t.Parallel()
@@ -124,6 +234,34 @@ func TestIsAlphanumeric(t *testing.T) {
 	}
 }
 
+func TestIsASCII(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"ｆｏｏbar", false},
+		{"ｘｙｚ０９８", false},
+		{"１２３456", false},
+		{"ｶﾀｶﾅ", false},
+		{"소주", false},
+
+		{"", true},
+		{"foobar", true},
+		{"0987654321", true},
+		{"test@example.com", true},
+		{"1234abcDEF", true},
+		{"\x00\x7f", true},
+	}
+	for _, test := range tests {
+		actual := IsASCII(test.param)
+		if actual != test.expected {
+			t.Errorf("Expected IsASCII(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+}
+
 func TestIsEmail(t *testing.T) {
 	t.Parallel()
 
@@ -189,6 +327,106 @@ func TestIsExistingEmail(t *testing.T) {
 	}
 }
 
+func TestIsFullWidth(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"abc", false},
+		{"abc123", false},
+		{"!\"#$%&()<>/+=-_? ~^|.,@`{}[]", false},
+
+		{"", true},
+		{"ひらがな・カタカナ、．漢字", true},
+		{"３ー０　ａ＠ｃｏｍ", true},
+		{"Ｆｶﾀｶﾅﾞﾬ", true},
+		{"Good＝Parts", true},
+	}
+	for _, test := range tests {
+		actual := IsFullWidth(test.param)
+		if actual != test.expected {
+			t.Errorf("Expected IsFullWidth(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestIsHalfWidth(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"あいうえお", false},
+		{"００１１", false},
+
+		{"", true},
+		{"!\"#$%&()<>/+=-_? ~^|.,@`{}[]", true},
+		{"l-btn_02--active", true},
+		{"abc123い", true},
+		{"ｶﾀｶﾅﾞﾬ￩", true},
+	}
+	for _, test := range tests {
+		actual := IsHalfWidth(test.param)
+		if actual != test.expected {
+			t.Errorf("Expected IsHalfWidth(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestIsIn(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		params   []string
+		expected bool
+	}{
+		{"PRESENT", []string{"PRESENT"}, true},
+		{"PRESENT", []string{"PRESENT", "PRÉSENTE", "NOTABSENT"}, true},
+		{"PRÉSENTE", []string{"PRESENT", "PRÉSENTE", "NOTABSENT"}, true},
+		{"PRESENT", []string{}, false},
+		{"PRESENT", nil, false},
+		{"ABSENT", []string{"PRESENT", "PRÉSENTE", "NOTABSENT"}, false},
+		{"", []string{"PRESENT"}, false},
+		{"", []string{""}, true},
+	}
+	for _, test := range tests {
+		actual := IsIn(test.param, test.params...)
+		if actual != test.expected {
+			t.Errorf("Expected IsIn(%q, %q) to be %v, got %v", test.param, test.params, test.expected, actual)
+		}
+	}
+}
+
+func TestIsMultibyte(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"abc", false},
+		{"123", false},
+		{"<>@;.-=", false},
+
+		{"", true},
+		{"ひらがな・カタカナ、．漢字", true},
+		{"あいうえお foobar", true},
+		{"test＠example.com", true},
+		{"1234abcDEｘｙｚ", true},
+		{"カタカナ", true},
+	}
+	for _, test := range tests {
+		actual := IsMultibyte(test.param)
+		if actual != test.expected {
+			t.Errorf("Expected IsMultibyte(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+}
+
 func TestIsNull(t *testing.T) {
 	t.Parallel()
 
@@ -288,6 +526,36 @@ func TestIsNumeric(t *testing.T) {
 	}
 }
 
+func TestIsPrintableASCII(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"ｆｏｏbar", false},
+		{"ｘｙｚ０９８", false},
+		{"１２３456", false},
+		{"ｶﾀｶﾅ", false},
+		{"\n", false},
+		{"\x19", false},
+		{"\x7f", false},
+
+		{"", true},
+		{" ", true},
+		{"foobar", true},
+		{"0987654321", true},
+		{"test@example.com", true},
+		{"1234abcDEF", true},
+	}
+	for _, test := range tests {
+		actual := IsPrintableASCII(test.param)
+		if actual != test.expected {
+			t.Errorf("Expected IsPrintableASCII(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+}
+
 func TestIsURL(t *testing.T) {
 	t.Parallel()
 
@@ -732,3 +1000,76 @@ func TestIsUTFNumeric(t *testing.T) {
 		}
 	}
 }
+
+func TestIsVariableWidth(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"ひらがなカタカナ漢字ABCDE", true},
+		{"３ー０123", true},
+		{"Ｆｶﾀｶﾅﾞﾬ", true},
+		{"Good＝Parts", true},
+		{"", true},
+
+		{"abc", false},
+		{"abc123", false},
+		{"!\"#$%&()<>/+=-_? ~^|.,@`{}[]", false},
+		{"ひらがな・カタカナ、．漢字", false},
+		{"１２３４５６", false},
+		{"ｶﾀｶﾅﾞﾬ", false},
+	}
+	for _, test := range tests {
+		actual := IsVariableWidth(test.param)
+		if actual != test.expected {
+			t.Errorf("Expected IsVariableWidth(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestMatches(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		pattern  string
+		expected bool
+	}{
+		{"123456789", "[0-9]+", true},
+		{"abacada", "cab$", false},
+		{"111222333", "((111|222|333)+)+", true},
+		{"abacaba", "((123+]", false},
+	}
+	for _, test := range tests {
+		actual := Matches(test.param, test.pattern)
+		if actual != test.expected {
+			t.Errorf("Expected Matches(%q, %q) to be %v, got %v", test.param, test.pattern, test.expected, actual)
+		}
+	}
+}
+
+func TestRuneLength(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		min      string
+		max      string
+		expected bool
+	}{
+		{"123456", "0", "100", true},
+		{"1239999", "0", "0", false},
+		{"你好世界", "4", "4", true},
+		{"你好世界", "0", "3", false},
+		{"", "0", "0", true},
+		{"abc", "x", "3", false},
+	}
+	for _, test := range tests {
+		actual := RuneLength(test.param, test.min, test.max)
+		if actual != test.expected {
+			t.Errorf("Expected RuneLength(%q, %q, %q) to be %v, got %v", test.param, test.min, test.max, test.expected, actual)
+		}
+	}
+}
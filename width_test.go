@@ -0,0 +1,33 @@
+package validator
+
+import "testing"
+
+func TestDisplayWidth(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected int
+	}{
+		{"", 0},
+		{"abc", 3},
+		{"\t\n", 0},
+		{"ｶﾀｶﾅ", 4},
+		{"カタカナ", 8},
+		{"漢字", 4},
+		{"山田 太郎", 9},
+		{"ＡＢＣ", 6},
+		{"한국어", 6},
+		{"é", 1},
+		{"a‍b", 2},
+		{"👍", 2},
+		{"𠮷野家", 6},
+		{"±½", 2},
+	}
+	for _, test := range tests {
+		actual := DisplayWidth(test.param)
+		if actual != test.expected {
+			t.Errorf("Expected DisplayWidth(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+}